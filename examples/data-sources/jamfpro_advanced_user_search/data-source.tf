@@ -0,0 +1,13 @@
+data "jamfpro_advanced_user_search" "by_id" {
+  id = jamfpro_advanced_user_search.advanced_user_search_001.id
+}
+
+# Several sites may each have a search named "All Users", so pick the one in a specific site
+data "jamfpro_advanced_user_search" "all_users_in_site" {
+  name    = "All Users"
+  site_id = 1
+}
+
+output "all_users_in_site_id" {
+  value = data.jamfpro_advanced_user_search.all_users_in_site.id
+}
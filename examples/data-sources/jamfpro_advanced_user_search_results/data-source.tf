@@ -0,0 +1,7 @@
+data "jamfpro_advanced_user_search_results" "expiring_passwords" {
+  name = "Passwords Expiring This Week"
+}
+
+output "expiring_password_usernames" {
+  value = data.jamfpro_advanced_user_search_results.expiring_passwords.users[*].username
+}
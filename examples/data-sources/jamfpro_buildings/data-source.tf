@@ -0,0 +1,17 @@
+data "jamfpro_buildings" "all" {}
+
+# Map every building name to its ID, so resources can reference buildings by name
+locals {
+  building_ids = {
+    for item in data.jamfpro_buildings.all.items : item.name => item.id
+  }
+}
+
+# Only the buildings whose name starts with "HQ"
+data "jamfpro_buildings" "hq" {
+  name_regex = "^HQ"
+}
+
+output "hq_building_ids" {
+  value = [for item in data.jamfpro_buildings.hq.items : item.id]
+}
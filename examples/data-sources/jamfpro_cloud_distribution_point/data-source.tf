@@ -0,0 +1,9 @@
+data "jamfpro_cloud_distribution_point" "current" {}
+
+output "jcds_enabled" {
+  value = data.jamfpro_cloud_distribution_point.current.jcds_enabled
+}
+
+output "cdn_type" {
+  value = data.jamfpro_cloud_distribution_point.current.cdn_type
+}
@@ -0,0 +1,11 @@
+data "jamfpro_computer" "by_serial" {
+  serial_number = "C02XK1JKJGH5"
+}
+
+output "computer_id" {
+  value = data.jamfpro_computer.by_serial.id
+}
+
+output "assigned_user" {
+  value = data.jamfpro_computer.by_serial.username
+}
@@ -0,0 +1,22 @@
+data "jamfpro_computer_extension_attributes" "all" {}
+
+# Emit one terraform import command per existing computer extension attribute, e.g. for onboarding a tenant:
+#   terraform output -json computer_extension_attribute_import_commands | jq -r '.[]' | sh
+output "computer_extension_attribute_import_commands" {
+  value = [
+    for ea in data.jamfpro_computer_extension_attributes.all.items :
+    "terraform import 'jamfpro_computer_extension_attribute.imported[\"${ea.name}\"]' ${ea.id}"
+  ]
+}
+
+# Find every script based extension attribute whose name starts with "Windows"
+data "jamfpro_computer_extension_attributes" "windows" {
+  name_regex = "^Windows"
+}
+
+output "windows_script_extension_attribute_ids" {
+  value = [
+    for ea in data.jamfpro_computer_extension_attributes.windows.items :
+    ea.id if ea.input_type == "SCRIPT"
+  ]
+}
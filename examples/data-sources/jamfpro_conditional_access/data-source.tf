@@ -0,0 +1,5 @@
+data "jamfpro_conditional_access" "current" {}
+
+output "shared_device_compliance_enabled" {
+  value = data.jamfpro_conditional_access.current.shared_device_feature_enabled
+}
@@ -0,0 +1,17 @@
+data "jamfpro_departments" "all" {}
+
+# Map every department name to its ID, so resources can reference departments by name
+locals {
+  department_ids = {
+    for item in data.jamfpro_departments.all.items : item.name => item.id
+  }
+}
+
+# Only the departments whose name starts with "HQ"
+data "jamfpro_departments" "hq" {
+  name_regex = "^HQ"
+}
+
+output "hq_department_ids" {
+  value = [for item in data.jamfpro_departments.hq.items : item.id]
+}
@@ -0,0 +1,12 @@
+data "jamfpro_device_enrollment" "abm" {
+  name = "Apple Business Manager"
+}
+
+resource "jamfpro_computer_prestage_enrollment" "example" {
+  # ...
+  device_enrollment_program_instance_id = data.jamfpro_device_enrollment.abm.id
+}
+
+output "abm_token_expiration_date" {
+  value = data.jamfpro_device_enrollment.abm.token_expiration_date
+}
@@ -0,0 +1,10 @@
+data "jamfpro_generated_config" "battery_ea" {
+  resource_type = "jamfpro_computer_extension_attribute"
+  resource_id   = "12"
+}
+
+# Write the import and resource blocks to a file, then run terraform plan to adopt the object.
+resource "local_file" "battery_ea" {
+  filename = "${path.module}/generated/battery_ea.tf"
+  content  = data.jamfpro_generated_config.battery_ea.hcl
+}
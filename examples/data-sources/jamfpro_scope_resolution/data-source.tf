@@ -0,0 +1,13 @@
+data "jamfpro_scope_resolution" "pilot" {
+  computer_group_ids = [12, 15]
+  building_ids       = [3]
+
+  exclusions {
+    computer_group_ids = [20]
+  }
+}
+
+# Review how many computers the scope reaches before attaching it to a policy.
+output "pilot_computer_count" {
+  value = data.jamfpro_scope_resolution.pilot.resolved_computer_count
+}
@@ -20,4 +20,16 @@ output "jamfpro_script_002_id" {
 
 output "jamfpro_script_002_name" {
   value = data.jamfpro_script.script_002_data.name
-}
\ No newline at end of file
+}
+
+# Look up a script managed outside Terraform by name, and show the arguments it expects
+data "jamfpro_script" "cleanup" {
+  name = "Cleanup Temp Files"
+}
+
+output "cleanup_script_parameter_labels" {
+  value = {
+    parameter4 = data.jamfpro_script.cleanup.parameter4
+    parameter5 = data.jamfpro_script.cleanup.parameter5
+  }
+}
@@ -20,4 +20,11 @@ output "jamfpro_site_002_id" {
 
 output "jamfpro_site_002_name" {
   value = data.jamfpro_site.site_002_data.name
-}
\ No newline at end of file
+}
+data "jamfpro_site" "site_by_name" {
+  name = "London"
+}
+
+output "jamfpro_site_by_name_id" {
+  value = data.jamfpro_site.site_by_name.id
+}
@@ -0,0 +1,5 @@
+# App installers can be imported by deployment ID
+terraform import jamfpro_app_installer.google_chrome 12
+
+# or by app title name, when the title has a single deployment
+terraform import jamfpro_app_installer.google_chrome "Google Chrome"
@@ -0,0 +1,18 @@
+resource "jamfpro_class" "year_7_science" {
+  name        = "Year 7 Science"
+  description = "Year 7 science, taught in Lab 2"
+
+  students = [
+    "student.one",
+    "student.two",
+  ]
+
+  teacher_ids             = [12]
+  mobile_device_group_ids = [4]
+
+  meeting_times {
+    days       = "M W F"
+    start_time = 900
+    end_time   = 1030
+  }
+}
@@ -0,0 +1,2 @@
+# There is one computer check-in configuration per Jamf Pro instance, so any ID imports it
+terraform import jamfpro_computer_checkin.jamfpro_computer_checkin jamfpro_computer_checkin_singleton
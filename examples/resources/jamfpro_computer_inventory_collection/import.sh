@@ -0,0 +1,2 @@
+# Jamf Pro has a single set of computer inventory collection settings, so any ID imports it
+terraform import jamfpro_computer_inventory_collection.example jamfpro_computer_inventory_collection_singleton
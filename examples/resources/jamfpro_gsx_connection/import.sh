@@ -0,0 +1,2 @@
+# There is one GSX connection per Jamf Pro instance, so any ID imports it
+terraform import jamfpro_gsx_connection.gsx jamfpro_gsx_connection_singleton
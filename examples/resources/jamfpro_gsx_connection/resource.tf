@@ -0,0 +1,6 @@
+resource "jamfpro_gsx_connection" "gsx" {
+  enabled            = true
+  username           = "gsx-admin@example.com"
+  service_account_no = "0001234567"
+  ship_to_no         = "0001234567"
+}
@@ -0,0 +1,22 @@
+resource "jamfpro_managed_software_update_plan" "macos_specific_version" {
+  group {
+    group_id    = "2"
+    object_type = "COMPUTER_GROUP"
+  }
+
+  update_action    = "DOWNLOAD_INSTALL_ALLOW_DEFERRAL"
+  version_type     = "SPECIFIC_VERSION"
+  specific_version = "15.1"
+  max_deferrals    = 3
+}
+
+resource "jamfpro_managed_software_update_plan" "ios_scheduled" {
+  group {
+    group_id    = "5"
+    object_type = "MOBILE_DEVICE_GROUP"
+  }
+
+  update_action                 = "DOWNLOAD_INSTALL_SCHEDULE"
+  version_type                  = "LATEST_ANY"
+  force_install_local_date_time = "2025-01-31T18:00:00"
+}
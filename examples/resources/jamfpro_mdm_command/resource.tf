@@ -0,0 +1,15 @@
+resource "jamfpro_mdm_command" "restart_lab_macs" {
+  command_type   = "RESTART_DEVICE"
+  management_ids = ["0a1b2c3d-4e5f-6789-abcd-ef0123456789"]
+  notify_user    = true
+
+  # Change this value to send the command again
+  trigger = "2024-06-01"
+}
+
+resource "jamfpro_mdm_command" "lost_ipad" {
+  command_type      = "ENABLE_LOST_MODE"
+  management_ids    = ["9f8e7d6c-5b4a-3210-fedc-ba9876543210"]
+  lost_mode_message = "This iPad has been reported lost."
+  lost_mode_phone   = "555-0100"
+}
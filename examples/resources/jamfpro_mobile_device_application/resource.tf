@@ -0,0 +1,36 @@
+// Example of a VPP App Store app deployed automatically to a mobile device group with a managed app configuration
+resource "jamfpro_mobile_device_application" "pages" {
+  name                  = "Pages"
+  display_name          = "Pages"
+  description           = "Documents that stand apart."
+  bundle_id             = "com.apple.Pages"
+  version               = "13.2"
+  app_store_url         = "https://apps.apple.com/us/app/pages/id361309726"
+  itunes_country_region = "US"
+  deployment_type       = "Install Automatically/Prompt Users to Install"
+
+  deploy_as_managed_app                  = true
+  remove_app_when_mdm_profile_is_removed = true
+  prevent_backup_of_app_data             = false
+  keep_description_and_icon_up_to_date   = true
+  free                                   = true
+
+  site_id     = -1
+  category_id = 5
+
+  vpp {
+    assign_vpp_device_based_licenses = true
+    vpp_admin_account_id             = 1
+  }
+
+  app_configuration_preferences = file("${path.module}/path/to/appconfig.plist")
+
+  scope {
+    all_mobile_devices      = false
+    mobile_device_group_ids = [201, 202]
+
+    exclusions {
+      mobile_device_group_ids = [1201]
+    }
+  }
+}
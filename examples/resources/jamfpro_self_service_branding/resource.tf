@@ -0,0 +1,7 @@
+resource "jamfpro_self_service_branding" "corporate" {
+  application_name         = "Acme Self Service"
+  branding_name            = "Acme"
+  branding_name_secondary  = "IT Services"
+  icon_id                  = 3
+  branding_header_image_id = 4
+}
@@ -26,3 +26,33 @@ resource "jamfpro_smart_computer_group" "smart_example" {
   }
 
 }
+
+# Criteria can instead be written as groups, which are compiled into
+# parentheses and priorities. This matches (a) or (b and c).
+resource "jamfpro_smart_computer_group" "grouped_example" {
+  name = "Example Grouped Smart Computer Group"
+
+  criteria_group {
+    criterion {
+      name        = "Operating System Version"
+      search_type = "like"
+      value       = "15."
+    }
+  }
+
+  criteria_group {
+    and_or = "or"
+
+    criterion {
+      name        = "Computer Name"
+      search_type = "like"
+      value       = "LAB-"
+    }
+
+    criterion {
+      name        = "Building"
+      search_type = "is"
+      value       = "Head Office"
+    }
+  }
+}
@@ -0,0 +1,3 @@
+# There is one set of SMTP server settings per Jamf Pro instance, so any ID imports it.
+# The password is not returned by Jamf Pro and must be set in configuration after import.
+terraform import jamfpro_smtp_server.smtp jamfpro_smtp_server_singleton
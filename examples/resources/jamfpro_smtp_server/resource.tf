@@ -0,0 +1,12 @@
+resource "jamfpro_smtp_server" "smtp" {
+  enabled                 = true
+  server                  = "smtp.example.com"
+  port                    = 587
+  encryption_type         = "TLS_1_2"
+  connection_timeout      = 5
+  sender_display_name     = "Jamf Pro"
+  sender_email_address    = "jamf@example.com"
+  requires_authentication = true
+  username                = "jamf@example.com"
+  password                = var.smtp_password
+}
@@ -0,0 +1,6 @@
+resource "jamfpro_software_update_server" "reposado" {
+  name            = "Reposado - London"
+  ip_address      = "sus.example.com"
+  port            = 8088
+  set_system_wide = true
+}
@@ -0,0 +1,2 @@
+# There is one SSO configuration per Jamf Pro instance, so any ID imports it
+terraform import jamfpro_sso_settings.sso jamfpro_sso_settings_singleton
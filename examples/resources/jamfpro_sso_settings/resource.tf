@@ -0,0 +1,17 @@
+resource "jamfpro_sso_settings" "sso" {
+  sso_enabled       = true
+  idp_provider_type = "AZURE"
+  idp_metadata_url  = "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/federationmetadata/2007-06/federationmetadata.xml"
+  user_mapping      = "EMAIL"
+  session_timeout   = 480
+
+  sso_bypass_allowed         = true
+  sso_for_enrollment_enabled = true
+}
+
+# Or with the metadata document kept alongside the configuration
+resource "jamfpro_sso_settings" "sso_inline" {
+  sso_enabled       = true
+  idp_provider_type = "OKTA"
+  idp_metadata_xml  = file("${path.module}/okta-metadata.xml")
+}
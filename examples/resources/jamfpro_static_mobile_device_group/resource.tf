@@ -0,0 +1,9 @@
+resource "jamfpro_static_mobile_device_group" "jamfpro_static_mobile_device_group_001" {
+  name = "Example Static Mobile Device Group"
+
+  # Optional Block
+  site_id = 1
+
+  # Optional: Specify mobile devices for static groups
+  assigned_mobile_device_ids = [1, 2, 3]
+}
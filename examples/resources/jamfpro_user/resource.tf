@@ -0,0 +1,13 @@
+resource "jamfpro_user" "jamfpro_user_001" {
+  name         = "svc-search-test"
+  full_name    = "Search Test Service User"
+  email        = "svc-search-test@example.com"
+  phone_number = "555-0100"
+  position     = "Service Account"
+
+  # Optional: Link the user to an LDAP server
+  ldap_server_id = 0
+
+  # Optional: Sites the user belongs to
+  site_ids = [1]
+}
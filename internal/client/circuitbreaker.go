@@ -0,0 +1,71 @@
+// client/circuitbreaker.go
+// This package contains a circuit breaker shared by every request the provider sends to Jamf Pro.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// unavailableMessage starts the error returned while the circuit is open. The SDK wraps errors with %v,
+// so IsJamfProUnavailable also matches on this text.
+const unavailableMessage = "Jamf Pro appears unavailable"
+
+// ErrJamfProUnavailable is returned for requests refused because the circuit breaker is open.
+var ErrJamfProUnavailable = errors.New(unavailableMessage)
+
+// CircuitBreaker stops requests to Jamf Pro for a cooldown once a number of consecutive requests have failed,
+// so that an outage fails every resource quickly instead of each one retrying until its own timeout.
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+}
+
+// NewCircuitBreaker returns a circuit breaker that opens for cooldown after threshold consecutive failures.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+	}
+}
+
+// Allow returns an error wrapping ErrJamfProUnavailable while the circuit is open.
+// Once the cooldown has passed requests are let through again, and a single further failure reopens the circuit.
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if remaining := time.Until(b.openUntil); remaining > 0 {
+		return fmt.Errorf("%w: %d consecutive requests failed, so requests are paused for another %s",
+			ErrJamfProUnavailable, b.failures, remaining.Round(time.Second))
+	}
+	return nil
+}
+
+// Record counts a request outcome, opening the circuit when the failure threshold is reached.
+func (b *CircuitBreaker) Record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !failed {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+}
+
+// IsJamfProUnavailable reports whether err was caused by the circuit breaker refusing a request.
+func IsJamfProUnavailable(err error) bool {
+	return errors.Is(err, ErrJamfProUnavailable) || strings.Contains(err.Error(), unavailableMessage)
+}
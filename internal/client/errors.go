@@ -0,0 +1,67 @@
+// client/errors.go
+// This package contains helpers for interpreting errors returned by the Jamf Pro SDK.
+
+package client
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// statusCodePattern matches the status code in a serialised APIError. The SDK wraps errors with %v,
+// so the original type is usually lost and the code has to be recovered from the message.
+var statusCodePattern = regexp.MustCompile(`"status_code":(\d{3})|StatusCode=(\d{3})`)
+
+// StatusCode returns the HTTP status code carried by an SDK error, or 0 if none can be found.
+func StatusCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var apiErr *response.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+
+	match := statusCodePattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0
+	}
+
+	code := match[1]
+	if code == "" {
+		code = match[2]
+	}
+
+	statusCode, _ := strconv.Atoi(code)
+	return statusCode
+}
+
+// IsRetryable reports whether an SDK error may succeed if the request is repeated.
+// Client errors (4xx) are permanent, except 429 Too Many Requests. Server errors,
+// timeouts and errors without a status code are treated as transient, unless the
+// circuit breaker refused the request.
+func IsRetryable(err error) bool {
+	if IsJamfProUnavailable(err) {
+		return false
+	}
+
+	statusCode := StatusCode(err)
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return statusCode < 400 || statusCode >= 500
+}
+
+// RetryError classifies an SDK error for use inside retry.RetryContext.
+func RetryError(err error) *retry.RetryError {
+	if IsRetryable(err) {
+		return retry.RetryableError(err)
+	}
+	return retry.NonRetryableError(err)
+}
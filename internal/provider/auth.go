@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+)
+
+// serializedTokenIntegration wraps the Jamf Pro integration so that only one request at a time can check and
+// refresh the bearer token. The integration stores the token without locking, and resources are applied in
+// parallel, so concurrent refreshes would otherwise race and request several tokens at once.
+type serializedTokenIntegration struct {
+	httpclient.APIIntegration
+	mu sync.Mutex
+	// build creates a new integration, with no token, for use when Jamf Pro rejects the current token.
+	build func() (httpclient.APIIntegration, error)
+	// authorization is the Authorization header most recently issued by the integration.
+	authorization string
+}
+
+// newSerializedTokenIntegration wraps integration, using build to replace it if its token is rejected.
+func newSerializedTokenIntegration(integration httpclient.APIIntegration, build func() (httpclient.APIIntegration, error)) *serializedTokenIntegration {
+	return &serializedTokenIntegration{APIIntegration: integration, build: build}
+}
+
+// CheckRefreshToken refreshes the token if it is missing, expired or about to expire.
+func (i *serializedTokenIntegration) CheckRefreshToken() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.APIIntegration.CheckRefreshToken()
+}
+
+// PrepRequestParamsAndAuth sets the request headers, refreshing the token first if needed.
+func (i *serializedTokenIntegration) PrepRequestParamsAndAuth(req *http.Request) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if err := i.APIIntegration.PrepRequestParamsAndAuth(req); err != nil {
+		return err
+	}
+	i.authorization = req.Header.Get("Authorization")
+	return nil
+}
+
+// reauthorize returns a fresh Authorization header after Jamf Pro rejected rejectedAuthorization with 401.
+// The token is only replaced if it is still the one that was rejected, so when many in-flight requests fail
+// together the first replaces the token and the rest reuse it.
+func (i *serializedTokenIntegration) reauthorize(rejectedAuthorization string) (string, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.authorization != rejectedAuthorization {
+		return i.authorization, nil
+	}
+
+	integration, err := i.build()
+	if err != nil {
+		return "", err
+	}
+	i.APIIntegration = integration
+
+	probe, err := http.NewRequest(http.MethodGet, integration.GetFQDN(), nil)
+	if err != nil {
+		return "", err
+	}
+	if err := integration.PrepRequestParamsAndAuth(probe); err != nil {
+		return "", err
+	}
+
+	i.authorization = probe.Header.Get("Authorization")
+	return i.authorization, nil
+}
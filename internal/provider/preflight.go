@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// minimumJamfProVersion is the oldest Jamf Pro release the provider supports.
+var minimumJamfProVersion = []int{11, 9, 1}
+
+// preflightCheck makes one authenticated request for the Jamf Pro version, so that a wrong URL, bad
+// credentials or an unsupported server fail when the provider is configured rather than part way
+// through the first resource operation. The version is recorded against the client for version gating.
+func preflightCheck(client *jamfpro.Client, fqdn string) diag.Diagnostics {
+	response, err := client.GetJamfProVersion()
+	if err != nil {
+		switch statusCode := jamfclient.StatusCode(err); {
+		case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden || strings.Contains(strings.ToLower(err.Error()), "token"):
+			// Token request failures come from the auth integration and usually carry no parsable status code.
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Jamf Pro rejected the provider credentials",
+				Detail:   fmt.Sprintf("Authenticating with %s failed. Check the auth_method and the client ID and secret, or username and password, and that the API client or account is enabled. Error: %v", fqdn, err),
+			}}
+		case statusCode == 0:
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Unable to reach Jamf Pro",
+				Detail:   fmt.Sprintf("No response was received from %s. Check jamfpro_instance_fqdn and network access to the server. Error: %v", fqdn, err),
+			}}
+		default:
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Jamf Pro connectivity check failed",
+				Detail:   fmt.Sprintf("Fetching the Jamf Pro version from %s failed with HTTP %d: %v", fqdn, statusCode, err),
+			}}
+		}
+	}
+
+	if response.Version == nil {
+		return nil
+	}
+
+	version, ok := common.ParseJamfProVersion(*response.Version)
+	if !ok {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unrecognised Jamf Pro version",
+			Detail:   fmt.Sprintf("%s reported version '%s', which could not be compared with the minimum supported version.", fqdn, *response.Version),
+		}}
+	}
+
+	common.SetJamfProVersion(client, version)
+
+	if common.CompareVersions(version, minimumJamfProVersion) < 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Unsupported Jamf Pro version",
+			Detail:   fmt.Sprintf("%s runs Jamf Pro %s, but the provider requires %s or later.", fqdn, *response.Version, common.FormatVersion(minimumJamfProVersion)),
+		}}
+	}
+
+	return nil
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/deploymenttheory/go-api-http-client-integrations/jamf/jamfprointegration"
 	"github.com/deploymenttheory/go-api-http-client/httpclient"
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/accountgroups"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/accounts"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/activationcode"
@@ -23,30 +25,49 @@ import (
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/appinstallers"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/buildings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/categories"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/classes"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/clouddistributionpoint"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/waitfor"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computercheckin"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computerextensionattributes"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computerinventory"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computerinventorycollection"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/computerprestageenrollments"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/conditionalaccess"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/departments"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/deviceenrollments"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/diskencryptionconfigurations"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/dockitems"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/filesharedistributionpoints"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/generatedconfig"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/gsxconnection"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/macosconfigurationprofilesplist"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/macosconfigurationprofilesplistgenerator"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/managedsoftwareupdateplans"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/mdmcommands"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/mobiledeviceapplications"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/mobiledeviceconfigurationprofilesplist"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/mobiledeviceextensionattributes"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/networksegments"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/packages"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/policies"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/printers"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/render"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/restrictedsoftware"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/scoperesolution"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/scripts"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/selfservicebranding"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/sites"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/smartcomputergroups"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/smartmobiledevicegroups"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/smtpsettings"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/softwareupdateservers"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/ssosettings"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/staticcomputergroups"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/staticmobiledevicegroups"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/usergroups"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/users"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/webhooks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -64,6 +85,28 @@ const (
 	envVarBasicAuthPassword           = "JAMFPRO_BASIC_PASSWORD"
 	envVarJamfProFQDN                 = "JAMFPRO_INSTANCE_FQDN"
 	envVarJamfProAuthMethod           = "JAMFPRO_AUTH_METHOD"
+	envVarEnableClientSDKLogs         = "JAMFPRO_ENABLE_CLIENT_SDK_LOGS"
+	envVarClientSDKLogExportPath      = "JAMFPRO_CLIENT_SDK_LOG_EXPORT_PATH"
+	envVarHideSensitiveData           = "JAMFPRO_HIDE_SENSITIVE_DATA"
+	envVarLoadBalancerLock            = "JAMFPRO_LOAD_BALANCER_LOCK"
+	envVarTokenRefreshBufferPeriod    = "JAMFPRO_TOKEN_REFRESH_BUFFER_PERIOD_SECONDS"
+	envVarMandatoryRequestDelay       = "JAMFPRO_MANDATORY_REQUEST_DELAY_MILLISECONDS"
+	envVarPropagationTimeout          = "JAMFPRO_PROPAGATION_TIMEOUT_SECONDS"
+	envVarRequestsPerMinute           = "JAMFPRO_REQUESTS_PER_MINUTE"
+	envVarMaxConcurrentRequests       = "JAMFPRO_MAX_CONCURRENT_REQUESTS"
+	envVarHTTPRequestTimeout          = "JAMFPRO_HTTP_REQUEST_TIMEOUT"
+	envVarEnforceUniqueNames          = "JAMFPRO_ENFORCE_UNIQUE_NAMES"
+	envVarEnableBulkReadCache         = "JAMFPRO_ENABLE_BULK_READ_CACHE"
+	envVarDisableNameFallback         = "JAMFPRO_DISABLE_NAME_FALLBACK"
+	envVarCircuitBreakerThreshold     = "JAMFPRO_CIRCUIT_BREAKER_THRESHOLD"
+	envVarCircuitBreakerCooldown      = "JAMFPRO_CIRCUIT_BREAKER_COOLDOWN_SECONDS"
+	envVarCACertFile                  = "JAMFPRO_CA_CERT_FILE"
+	envVarClientCertFile              = "JAMFPRO_CLIENT_CERT_FILE"
+	envVarClientKeyFile               = "JAMFPRO_CLIENT_KEY_FILE"
+	envVarInsecureSkipVerify          = "JAMFPRO_INSECURE_SKIP_VERIFY"
+	envVarProxyURL                    = "JAMFPRO_PROXY_URL"
+	envVarProxyUsername               = "JAMFPRO_PROXY_USERNAME"
+	envVarProxyPassword               = "JAMFPRO_PROXY_PASSWORD"
 	jamfLoadBalancerCookieName        = "jpro-ingress"
 )
 
@@ -211,7 +254,8 @@ func GetBasicAuthPassword(d *schema.ResourceData, diags *diag.Diagnostics) strin
 
 /*
 GetAuthMethod retrieves the auth method from the provided schema resource data.
-If the auth method is not found, it appends an error diagnostic to the diagnostics slice.
+If no auth method is set, OAuth client credentials are used when a client ID and secret are configured,
+otherwise basic auth is used. An error diagnostic is appended if neither set of credentials is configured.
 
 Parameters:
 
@@ -220,20 +264,33 @@ Parameters:
 
 Returns:
 
-	A string representing the auth method. If the auth method is not provided,
-	an error diagnostic is appended to diags and an empty string is returned.
+	A string representing the auth method, either "oauth2" or "basic".
 */
 func GetAuthMethod(d *schema.ResourceData, diags *diag.Diagnostics) string {
-	authMethod, ok := d.GetOk("auth_method")
-	if !ok || authMethod.(string) == "" {
+	if authMethod, ok := d.GetOk("auth_method"); ok && authMethod.(string) != "" {
+		return strings.ToLower(authMethod.(string))
+	}
+
+	oauthConfigured := d.Get("client_id").(string) != "" && d.Get("client_secret").(string) != ""
+	basicConfigured := d.Get("basic_auth_username").(string) != "" && d.Get("basic_auth_password").(string) != ""
+
+	switch {
+	case oauthConfigured:
+		return "oauth2"
+	case basicConfigured:
+		return "basic"
+	case d.Get("client_id").(string) != "":
+		// Let GetClientSecret report the missing half of the OAuth credentials.
+		return "oauth2"
+	default:
 		*diags = append(*diags, diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  "Error getting auth method",
-			Detail:   "auth_method must be provided either as an environment variable (JAMFPRO_AUTH_METHOD) or in the Terraform configuration",
+			Summary:  "No Jamf Pro credentials configured",
+			Detail: fmt.Sprintf("Set client_id and client_secret (%s, %s), or basic_auth_username and basic_auth_password (%s, %s), in the Terraform configuration or as environment variables.",
+				envVarOAuthClientId, envVarOAuthClientSecret, envVarBasicAuthUsername, envVarBasicAuthPassword),
 		})
-		return ""
+		return "basic"
 	}
-	return authMethod.(string)
 }
 
 // Schema defines the configuration attributes for the  within the JamfPro provider.
@@ -249,9 +306,9 @@ func Provider() *schema.Provider {
 			},
 			"auth_method": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(envVarJamfProAuthMethod, ""),
-				Description: "Auth method chosen for Jamf.",
+				Description: "Auth method chosen for Jamf: 'oauth2' for API client credentials or 'basic' for a username and password. When omitted, 'oauth2' is used if client_id and client_secret are set, and 'basic' if basic_auth_username and basic_auth_password are set.",
 				ValidateFunc: validation.StringInSlice([]string{
 					"basic", "oauth2",
 				}, true),
@@ -285,19 +342,19 @@ func Provider() *schema.Provider {
 			"enable_client_sdk_logs": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     false,
+				DefaultFunc: schema.EnvDefaultFunc(envVarEnableClientSDKLogs, false),
 				Description: "Debug option to propogate logs from the SDK and HttpClient",
 			},
 			"client_sdk_log_export_path": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "",
+				DefaultFunc: schema.EnvDefaultFunc(envVarClientSDKLogExportPath, ""),
 				Description: "Specify the path to export http client logs to.",
 			},
 			"hide_sensitive_data": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarHideSensitiveData, true),
 				Description: "Define whether sensitive fields should be hidden in logs. Default to hiding sensitive data in logs",
 			},
 			"custom_cookies": {
@@ -320,59 +377,222 @@ func Provider() *schema.Provider {
 					},
 				},
 			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarCACertFile, ""),
+				Description: "Path to a PEM file of CA certificates to trust, in addition to the system roots, for a Jamf Pro server using a certificate from a private CA.",
+			},
+			"client_cert_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarClientCertFile, ""),
+				RequiredWith: []string{"client_key_file"},
+				Description:  "Path to a PEM client certificate presented to Jamf Pro, for servers that require mutual TLS. Requires client_key_file.",
+			},
+			"client_key_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarClientKeyFile, ""),
+				RequiredWith: []string{"client_cert_file"},
+				Description:  "Path to the PEM private key for client_cert_file.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarInsecureSkipVerify, false),
+				Description: "Skip verification of the Jamf Pro server certificate. Only for testing: it exposes API credentials to interception. Prefer ca_cert_file.",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarProxyURL, ""),
+				Description: "URL of a forward proxy for all Jamf Pro API requests, such as http://proxy.example.com:3128. When omitted, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.",
+			},
+			"proxy_username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarProxyUsername, ""),
+				RequiredWith: []string{"proxy_url"},
+				Description:  "Username for proxy_url, if the proxy requires authentication.",
+			},
+			"proxy_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarProxyPassword, ""),
+				RequiredWith: []string{"proxy_username"},
+				Description:  "Password for proxy_username.",
+			},
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"NO_PROXY", "no_proxy"}, ""),
+				Description: "Comma separated hosts, domains and CIDR ranges that bypass proxy_url, in the NO_PROXY format. Defaults to the NO_PROXY environment variable.",
+			},
 			"jamfpro_load_balancer_lock": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     false,
+				DefaultFunc: schema.EnvDefaultFunc(envVarLoadBalancerLock, false),
 				Description: "Programatically determines all available web app members in the load balance and locks all instances of httpclient to the app for faster executions. \nTEMP SOLUTION UNTIL JAMF PROVIDES SOLUTION",
 			},
 			"token_refresh_buffer_period_seconds": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Default:     300,
+				DefaultFunc: schema.EnvDefaultFunc(envVarTokenRefreshBufferPeriod, 300),
 				Description: "The buffer period in seconds for token refresh.",
 			},
 
 			"mandatory_request_delay_milliseconds": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Default:     100,
+				DefaultFunc: schema.EnvDefaultFunc(envVarMandatoryRequestDelay, 100),
 				Description: "A mandatory delay after each request before returning to reduce high volume of requests in a short time",
 			},
+			"propagation_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarPropagationTimeout, 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "How long to wait, in seconds, for a newly created object to become readable before failing. Jamf Cloud can accept a create before the object is queryable. Set to 0 to use each resource's create timeout.",
+			},
+			"requests_per_minute": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarRequestsPerMinute, 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of API requests sent per minute. Requests beyond the limit wait rather than fail. Set to 0 to disable rate limiting.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarMaxConcurrentRequests, 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of API requests in flight at once, whatever Terraform's -parallelism. Further requests wait for one to finish. Set to 0 for no limit.",
+			},
+			"http_request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarHTTPRequestTimeout, 60),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "How long to wait, in seconds, for Jamf Pro to start responding to a single API request before it fails and can be retried. Time spent uploading files is not counted. Set to 0 to wait indefinitely.",
+			},
+			"circuit_breaker_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarCircuitBreakerThreshold, 10),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of consecutive API requests that can fail to reach Jamf Pro, across all resources, before further requests fail immediately for circuit_breaker_cooldown_seconds. Set to 0 to disable the circuit breaker.",
+			},
+			"circuit_breaker_cooldown_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc(envVarCircuitBreakerCooldown, 60),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "How long, in seconds, API requests fail immediately once the circuit breaker opens.",
+			},
+			"enforce_unique_names": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarEnforceUniqueNames, false),
+				Description: "Check at plan time that new or renamed extension attributes and advanced searches do not share a name with an existing object in Jamf Pro.",
+			},
+			"disable_name_fallback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarDisableNameFallback, false),
+				Description: "Fail a delete when deleting by ID fails, instead of falling back to deleting by name. Affects the macOS and mobile device configuration profile resources, where a duplicate name could otherwise remove the wrong object.",
+			},
+			"enable_bulk_read_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envVarEnableBulkReadCache, false),
+				Description: "Serve resource reads from a single list request per resource type, cached in memory for the duration of the run. Speeds up refreshes of large states. Only supported by some resource types.",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 
 			"jamfpro_account":                                   accounts.DataSourceJamfProAccounts(),
 			"jamfpro_account_group":                             accountgroups.DataSourceJamfProAccountGroups(),
+			"jamfpro_account_groups":                            accountgroups.DataSourceJamfProAccountGroupsList(),
+			"jamfpro_accounts":                                  accounts.DataSourceJamfProAccountsList(),
 			"jamfpro_advanced_computer_search":                  advancedcomputersearches.DataSourceJamfProAdvancedComputerSearches(),
+			"jamfpro_advanced_computer_searches":                advancedcomputersearches.DataSourceJamfProAdvancedComputerSearchesList(),
 			"jamfpro_advanced_mobile_device_search":             advancedmobiledevicesearches.DataSourceJamfProAdvancedMobileDeviceSearches(),
+			"jamfpro_advanced_mobile_device_searches":           advancedmobiledevicesearches.DataSourceJamfProAdvancedMobileDeviceSearchesList(),
 			"jamfpro_advanced_user_search":                      advancedusersearches.DataSourceJamfProAdvancedUserSearches(),
+			"jamfpro_advanced_user_searches":                    advancedusersearches.DataSourceJamfProAdvancedUserSearchesList(),
+			"jamfpro_advanced_user_search_results":              advancedusersearches.DataSourceJamfProAdvancedUserSearchResults(),
+			"jamfpro_allowed_file_extensions":                   allowedfileextensions.DataSourceJamfProAllowedFileExtensionsList(),
 			"jamfpro_api_integration":                           apiintegrations.DataSourceJamfProApiIntegrations(),
+			"jamfpro_api_integrations":                          apiintegrations.DataSourceJamfProApiIntegrationsList(),
 			"jamfpro_api_role":                                  apiroles.DataSourceJamfProAPIRoles(),
+			"jamfpro_api_roles":                                 apiroles.DataSourceJamfProAPIRolesList(),
 			"jamfpro_building":                                  buildings.DataSourceJamfProBuildings(),
+			"jamfpro_buildings":                                 buildings.DataSourceJamfProBuildingsList(),
+			"jamfpro_categories":                                categories.DataSourceJamfProCategoriesList(),
 			"jamfpro_category":                                  categories.DataSourceJamfProCategories(),
+			"jamfpro_classes":                                   classes.DataSourceJamfProClassesList(),
+			"jamfpro_cloud_distribution_point":                  clouddistributionpoint.DataSourceJamfProCloudDistributionPoint(),
+			"jamfpro_computer":                                  computerinventory.DataSourceJamfProComputer(),
 			"jamfpro_computer_extension_attribute":              computerextensionattributes.DataSourceJamfProComputerExtensionAttributes(),
+			"jamfpro_computer_extension_attributes":             computerextensionattributes.DataSourceJamfProComputerExtensionAttributesList(),
 			"jamfpro_computer_inventory":                        computerinventory.DataSourceJamfProComputerInventory(),
 			"jamfpro_computer_prestage_enrollment":              computerprestageenrollments.DataSourceJamfProComputerPrestageEnrollmentEnrollment(),
+			"jamfpro_computer_prestage_enrollments":             computerprestageenrollments.DataSourceJamfProComputerPrestageEnrollmentsList(),
+			"jamfpro_conditional_access":                        conditionalaccess.DataSourceJamfProConditionalAccess(),
 			"jamfpro_department":                                departments.DataSourceJamfProDepartments(),
+			"jamfpro_departments":                               departments.DataSourceJamfProDepartmentsList(),
+			"jamfpro_device_enrollment":                         deviceenrollments.DataSourceJamfProDeviceEnrollment(),
 			"jamfpro_disk_encryption_configuration":             diskencryptionconfigurations.DataSourceJamfProDiskEncryptionConfigurations(),
+			"jamfpro_disk_encryption_configurations":            diskencryptionconfigurations.DataSourceJamfProDiskEncryptionConfigurationsList(),
 			"jamfpro_dock_item":                                 dockitems.DataSourceJamfProDockItems(),
+			"jamfpro_dock_items":                                dockitems.DataSourceJamfProDockItemsList(),
 			"jamfpro_file_share_distribution_point":             filesharedistributionpoints.DataSourceJamfProFileShareDistributionPoints(),
-			"jamfpro_network_segment":                           networksegments.DataSourceJamfProNetworkSegments(),
+			"jamfpro_file_share_distribution_points":            filesharedistributionpoints.DataSourceJamfProFileShareDistributionPointsList(),
 			"jamfpro_macos_configuration_profile_plist":         macosconfigurationprofilesplist.DataSourceJamfProMacOSConfigurationProfilesPlist(),
+			"jamfpro_macos_configuration_profiles":              macosconfigurationprofilesplist.DataSourceJamfProMacOSConfigurationProfilesPlistList(),
+			"jamfpro_mobile_device_applications":                mobiledeviceapplications.DataSourceJamfProMobileDeviceApplicationsList(),
 			"jamfpro_mobile_device_configuration_profile_plist": mobiledeviceconfigurationprofilesplist.DataSourceJamfProMobileDeviceConfigurationProfilesPlist(),
+			"jamfpro_mobile_device_configuration_profiles":      mobiledeviceconfigurationprofilesplist.DataSourceJamfProMobileDeviceConfigurationProfilesPlistList(),
 			/* "jamfpro_mobile_device_extension_attribute":         mobiledeviceextensionattribute.DataSourceJamfProMobileDeviceExtensionAttributes(), */
-			"jamfpro_package":                   packages.DataSourceJamfProPackages(),
-			"jamfpro_policy":                    policies.DataSourceJamfProPolicies(),
-			"jamfpro_printer":                   printers.DataSourceJamfProPrinters(),
-			"jamfpro_script":                    scripts.DataSourceJamfProScripts(),
-			"jamfpro_site":                      sites.DataSourceJamfProSites(),
-			"jamfpro_smart_computer_group":      smartcomputergroups.DataSourceJamfProSmartComputerGroups(),
-			"jamfpro_smart_mobile_device_group": smartmobiledevicegroups.DataSourceJamfProSmartMobileGroups(),
-			"jamfpro_static_computer_group":     staticcomputergroups.DataSourceJamfProStaticComputerGroups(),
-			"jamfpro_restricted_software":       restrictedsoftware.DataSourceJamfProRestrictedSoftwares(),
-			"jamfpro_user_group":                usergroups.DataSourceJamfProUserGroups(),
-			"jamfpro_webhook":                   webhooks.DataSourceJamfProWebhooks(),
+			"jamfpro_mobile_device_extension_attributes": mobiledeviceextensionattributes.DataSourceJamfProMobileDeviceExtensionAttributesList(),
+			"jamfpro_network_segment":                    networksegments.DataSourceJamfProNetworkSegments(),
+			"jamfpro_network_segments":                   networksegments.DataSourceJamfProNetworkSegmentsList(),
+			"jamfpro_package":                            packages.DataSourceJamfProPackages(),
+			"jamfpro_packages":                           packages.DataSourceJamfProPackagesList(),
+			"jamfpro_policies":                           policies.DataSourceJamfProPoliciesList(),
+			"jamfpro_policy":                             policies.DataSourceJamfProPolicies(),
+			"jamfpro_printer":                            printers.DataSourceJamfProPrinters(),
+			"jamfpro_printers":                           printers.DataSourceJamfProPrintersList(),
+			"jamfpro_render": render.DataSourceJamfProRender(map[string]render.Renderer{
+				"jamfpro_app_installer":                               {Resource: appinstallers.ResourceJamfProAppInstallers, Construct: appinstallers.Render},
+				"jamfpro_computer_extension_attribute":                {Resource: computerextensionattributes.ResourceJamfProComputerExtensionAttributes, Construct: computerextensionattributes.Render},
+				"jamfpro_macos_configuration_profile_plist":           {Resource: macosconfigurationprofilesplist.ResourceJamfProMacOSConfigurationProfilesPlist, Construct: macosconfigurationprofilesplist.Render},
+				"jamfpro_macos_configuration_profile_plist_generator": {Resource: macosconfigurationprofilesplistgenerator.ResourceJamfProMacOSConfigurationProfilesPlistGenerator, Construct: macosconfigurationprofilesplistgenerator.Render},
+				"jamfpro_policy":                                      {Resource: policies.ResourceJamfProPolicies, Construct: policies.Render},
+				"jamfpro_restricted_software":                         {Resource: restrictedsoftware.ResourceJamfProRestrictedSoftwares, Construct: restrictedsoftware.Render},
+				"jamfpro_script":                                      {Resource: scripts.ResourceJamfProScripts, Construct: scripts.Render},
+				"jamfpro_smart_computer_group":                        {Resource: smartcomputergroups.ResourceJamfProSmartComputerGroups, Construct: smartcomputergroups.Render},
+			}),
+			"jamfpro_restricted_software":        restrictedsoftware.DataSourceJamfProRestrictedSoftwares(),
+			"jamfpro_restricted_softwares":       restrictedsoftware.DataSourceJamfProRestrictedSoftwaresList(),
+			"jamfpro_scope_resolution":           scoperesolution.DataSourceJamfProScopeResolution(),
+			"jamfpro_script":                     scripts.DataSourceJamfProScripts(),
+			"jamfpro_scripts":                    scripts.DataSourceJamfProScriptsList(),
+			"jamfpro_site":                       sites.DataSourceJamfProSites(),
+			"jamfpro_sites":                      sites.DataSourceJamfProSitesList(),
+			"jamfpro_smart_computer_group":       smartcomputergroups.DataSourceJamfProSmartComputerGroups(),
+			"jamfpro_smart_computer_groups":      smartcomputergroups.DataSourceJamfProSmartComputerGroupsList(),
+			"jamfpro_smart_mobile_device_group":  smartmobiledevicegroups.DataSourceJamfProSmartMobileGroups(),
+			"jamfpro_smart_mobile_device_groups": smartmobiledevicegroups.DataSourceJamfProSmartMobileGroupsList(),
+			"jamfpro_software_update_servers":    softwareupdateservers.DataSourceJamfProSoftwareUpdateServersList(),
+			"jamfpro_static_computer_group":      staticcomputergroups.DataSourceJamfProStaticComputerGroups(),
+			"jamfpro_static_computer_groups":     staticcomputergroups.DataSourceJamfProStaticComputerGroupsList(),
+			"jamfpro_user_group":                 usergroups.DataSourceJamfProUserGroups(),
+			"jamfpro_user_groups":                usergroups.DataSourceJamfProUserGroupsList(),
+			"jamfpro_webhook":                    webhooks.DataSourceJamfProWebhooks(),
+			"jamfpro_webhooks":                   webhooks.DataSourceJamfProWebhooksList(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"jamfpro_account":                                     accounts.ResourceJamfProAccounts(),
@@ -387,7 +607,9 @@ func Provider() *schema.Provider {
 			"jamfpro_app_installer":                               appinstallers.ResourceJamfProAppInstallers(),
 			"jamfpro_building":                                    buildings.ResourceJamfProBuildings(),
 			"jamfpro_category":                                    categories.ResourceJamfProCategories(),
+			"jamfpro_class":                                       classes.ResourceJamfProClasses(),
 			"jamfpro_computer_checkin":                            computercheckin.ResourceJamfProComputerCheckin(),
+			"jamfpro_gsx_connection":                              gsxconnection.ResourceJamfProGSXConnection(),
 			"jamfpro_computer_extension_attribute":                computerextensionattributes.ResourceJamfProComputerExtensionAttributes(),
 			"jamfpro_computer_inventory_collection":               computerinventorycollection.ResourceJamfProComputerInventoryCollection(),
 			"jamfpro_computer_prestage_enrollment":                computerprestageenrollments.ResourceJamfProComputerPrestageEnrollmentEnrollment(),
@@ -398,23 +620,34 @@ func Provider() *schema.Provider {
 			"jamfpro_network_segment":                             networksegments.ResourceJamfProNetworkSegments(),
 			"jamfpro_macos_configuration_profile_plist":           macosconfigurationprofilesplist.ResourceJamfProMacOSConfigurationProfilesPlist(),
 			"jamfpro_macos_configuration_profile_plist_generator": macosconfigurationprofilesplistgenerator.ResourceJamfProMacOSConfigurationProfilesPlistGenerator(),
-			//"jamfpro_managed_software_update":                     managedsoftwareupdates.ResourceJamfProManagedSoftwareUpdate(),
-			"jamfpro_mobile_device_configuration_profile_plist": mobiledeviceconfigurationprofilesplist.ResourceJamfProMobileDeviceConfigurationProfilesPlist(),
-			"jamfpro_mobile_device_extension_attribute":         mobiledeviceextensionattributes.ResourceJamfProMobileDeviceExtensionAttributes(),
-			"jamfpro_package":                   packages.ResourceJamfProPackages(),
-			"jamfpro_policy":                    policies.ResourceJamfProPolicies(),
-			"jamfpro_printer":                   printers.ResourceJamfProPrinters(),
-			"jamfpro_script":                    scripts.ResourceJamfProScripts(),
-			"jamfpro_site":                      sites.ResourceJamfProSites(),
-			"jamfpro_smart_computer_group":      smartcomputergroups.ResourceJamfProSmartComputerGroups(),
-			"jamfpro_smart_mobile_device_group": smartmobiledevicegroups.ResourceJamfProSmartMobileGroups(),
-			"jamfpro_static_computer_group":     staticcomputergroups.ResourceJamfProStaticComputerGroups(),
-			"jamfpro_restricted_software":       restrictedsoftware.ResourceJamfProRestrictedSoftwares(),
-			"jamfpro_user_group":                usergroups.ResourceJamfProUserGroups(),
-			"jamfpro_webhook":                   webhooks.ResourceJamfProWebhooks(),
+			"jamfpro_managed_software_update_plan":                managedsoftwareupdateplans.ResourceJamfProManagedSoftwareUpdatePlans(),
+			"jamfpro_mdm_command":                                 mdmcommands.ResourceJamfProMDMCommands(),
+			"jamfpro_mobile_device_application":                   mobiledeviceapplications.ResourceJamfProMobileDeviceApplications(),
+			"jamfpro_mobile_device_configuration_profile_plist":   mobiledeviceconfigurationprofilesplist.ResourceJamfProMobileDeviceConfigurationProfilesPlist(),
+			"jamfpro_mobile_device_extension_attribute":           mobiledeviceextensionattributes.ResourceJamfProMobileDeviceExtensionAttributes(),
+			"jamfpro_package":                                     packages.ResourceJamfProPackages(),
+			"jamfpro_policy":                                      policies.ResourceJamfProPolicies(),
+			"jamfpro_printer":                                     printers.ResourceJamfProPrinters(),
+			"jamfpro_script":                                      scripts.ResourceJamfProScripts(),
+			"jamfpro_self_service_branding":                       selfservicebranding.ResourceJamfProSelfServiceBranding(),
+			"jamfpro_site":                                        sites.ResourceJamfProSites(),
+			"jamfpro_smart_computer_group":                        smartcomputergroups.ResourceJamfProSmartComputerGroups(),
+			"jamfpro_software_update_server":                      softwareupdateservers.ResourceJamfProSoftwareUpdateServers(),
+			"jamfpro_smart_mobile_device_group":                   smartmobiledevicegroups.ResourceJamfProSmartMobileGroups(),
+			"jamfpro_smtp_server":                                 smtpsettings.ResourceJamfProSMTPServer(),
+			"jamfpro_sso_settings":                                ssosettings.ResourceJamfProSsoSettings(),
+			"jamfpro_static_computer_group":                       staticcomputergroups.ResourceJamfProStaticComputerGroups(),
+			"jamfpro_static_mobile_device_group":                  staticmobiledevicegroups.ResourceJamfProStaticMobileDeviceGroups(),
+			"jamfpro_restricted_software":                         restrictedsoftware.ResourceJamfProRestrictedSoftwares(),
+			"jamfpro_user_group":                                  usergroups.ResourceJamfProUserGroups(),
+			"jamfpro_user":                                        users.ResourceJamfProUsers(),
+			"jamfpro_webhook":                                     webhooks.ResourceJamfProWebhooks(),
 		},
 	}
 
+	// jamfpro_generated_config covers every importable resource, so it is added once the resources are known.
+	provider.DataSourcesMap["jamfpro_generated_config"] = generatedconfig.DataSourceJamfProGeneratedConfig(provider.ResourcesMap)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var err error
 		var diags diag.Diagnostics
@@ -468,34 +701,48 @@ func Provider() *schema.Provider {
 		authMethod := GetAuthMethod(d, &diags)
 		tokenRefrshBufferPeriod := time.Duration(d.Get("token_refresh_buffer_period_seconds").(int)) * time.Second
 
+		tlsConfig, tlsDiags := buildTLSConfig(d)
+		diags = append(diags, tlsDiags...)
+		proxy, proxyDiags := buildProxyFunc(d)
+		diags = append(diags, proxyDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
 		hide_sensitive_data := d.Get("hide_sensitive_data").(bool)
-		bootstrapProdExecutor := &httpclient.ProdExecutor{Client: &http.Client{}}
+		bootstrapProdExecutor := &httpclient.ProdExecutor{Client: &http.Client{Transport: newTransport(tlsConfig, proxy)}}
+		// buildIntegration is kept so that the token can be replaced if Jamf Pro rejects it mid-apply.
+		var buildIntegration func() (*jamfprointegration.Integration, error)
 		switch authMethod {
 		case "oauth2":
 			clientId = GetClientID(d, &diags)
 			clientSecret = GetClientSecret(d, &diags)
-			jamfIntegration, err = jamfprointegration.BuildWithOAuth(
-				jamfFQDN,
-				sugaredLogger,
-				tokenRefrshBufferPeriod,
-				clientId,
-				clientSecret,
-				hide_sensitive_data,
-				bootstrapProdExecutor,
-			)
+			buildIntegration = func() (*jamfprointegration.Integration, error) {
+				return jamfprointegration.BuildWithOAuth(
+					jamfFQDN,
+					sugaredLogger,
+					tokenRefrshBufferPeriod,
+					clientId,
+					clientSecret,
+					hide_sensitive_data,
+					bootstrapProdExecutor,
+				)
+			}
 
 		case "basic":
 			basicAuthUsername = GetBasicAuthUsername(d, &diags)
 			basicAuthPassword = GetBasicAuthPassword(d, &diags)
-			jamfIntegration, err = jamfprointegration.BuildWithBasicAuth(
-				jamfFQDN,
-				sugaredLogger,
-				tokenRefrshBufferPeriod,
-				basicAuthUsername,
-				basicAuthPassword,
-				hide_sensitive_data,
-				bootstrapProdExecutor,
-			)
+			buildIntegration = func() (*jamfprointegration.Integration, error) {
+				return jamfprointegration.BuildWithBasicAuth(
+					jamfFQDN,
+					sugaredLogger,
+					tokenRefrshBufferPeriod,
+					basicAuthUsername,
+					basicAuthPassword,
+					hide_sensitive_data,
+					bootstrapProdExecutor,
+				)
+			}
 
 		default:
 			return nil, append(diags, diag.Diagnostic{
@@ -506,6 +753,12 @@ func Provider() *schema.Provider {
 
 		}
 
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		jamfIntegration, err = buildIntegration()
+
 		if err != nil {
 			return nil, append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -581,16 +834,29 @@ func Provider() *schema.Provider {
 			}
 		}
 
+		tokenIntegration := newSerializedTokenIntegration(jamfIntegration, func() (httpclient.APIIntegration, error) {
+			integration, err := buildIntegration()
+			if err != nil {
+				return nil, err
+			}
+			return integration, nil
+		})
+
+		var breaker *jamfclient.CircuitBreaker
+		if threshold := d.Get("circuit_breaker_threshold").(int); threshold > 0 {
+			breaker = jamfclient.NewCircuitBreaker(threshold, time.Duration(d.Get("circuit_breaker_cooldown_seconds").(int))*time.Second)
+		}
+
 		// Packaging
 		config := httpclient.ClientConfig{
-			Integration:              jamfIntegration,
+			Integration:              tokenIntegration,
 			Sugar:                    sugaredLogger,
 			HideSensitiveData:        d.Get("hide_sensitive_data").(bool),
 			TokenRefreshBufferPeriod: tokenRefrshBufferPeriod,
 			CustomCookies:            cookiesList,
 			MandatoryRequestDelay:    time.Duration(d.Get("mandatory_request_delay_milliseconds").(int)) * time.Millisecond,
 			RetryEligiableRequests:   false, // Forced off for now
-			HTTPExecutor:             &httpclient.ProdExecutor{Client: &http.Client{}},
+			HTTPExecutor:             &httpclient.ProdExecutor{Client: NewHTTPClient(d.Get("requests_per_minute").(int), d.Get("max_concurrent_requests").(int), time.Duration(d.Get("http_request_timeout").(int))*time.Second, tokenIntegration, breaker, newTransport(tlsConfig, proxy))},
 		}
 
 		goHttpClient, err := config.Build()
@@ -602,6 +868,25 @@ func Provider() *schema.Provider {
 			HTTP: goHttpClient,
 		}
 
+		diags = append(diags, preflightCheck(&jamfClient, jamfFQDN)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		waitfor.SetPropagationTimeout(time.Duration(d.Get("propagation_timeout_seconds").(int)) * time.Second)
+
+		if d.Get("enforce_unique_names").(bool) {
+			common.EnableUniqueNameChecks(&jamfClient)
+		}
+
+		if d.Get("enable_bulk_read_cache").(bool) {
+			common.EnableReadCache(&jamfClient)
+		}
+
+		if d.Get("disable_name_fallback").(bool) {
+			common.DisableNameFallback(&jamfClient)
+		}
+
 		return &jamfClient, diags
 	}
 
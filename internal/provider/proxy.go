@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// buildProxyFunc returns the proxy selection for Jamf Pro API requests. With proxy_url set, every request not
+// matched by no_proxy goes through it, authenticating with proxy_username and proxy_password if given.
+// Otherwise the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables apply, as for any Go program.
+func buildProxyFunc(d *schema.ResourceData) (func(*http.Request) (*url.URL, error), diag.Diagnostics) {
+	proxyURL := d.Get("proxy_url").(string)
+	if proxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	parsed, err := url.Parse(proxyURL)
+	if err != nil || parsed.Host == "" {
+		return nil, diag.Errorf("proxy_url '%s' is not a valid URL such as http://proxy.example.com:3128", proxyURL)
+	}
+
+	if username := d.Get("proxy_username").(string); username != "" {
+		parsed.User = url.UserPassword(username, d.Get("proxy_password").(string))
+	}
+
+	proxyConfig := httpproxy.Config{
+		HTTPProxy:  parsed.String(),
+		HTTPSProxy: parsed.String(),
+		NoProxy:    d.Get("no_proxy").(string),
+	}
+	proxyFunc := proxyConfig.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		proxy, err := proxyFunc(req.URL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to select a proxy for %s: %v", req.URL.Host, err)
+		}
+		return proxy, nil
+	}, nil
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// buildTLSConfig returns the TLS settings for connections to a self-hosted Jamf Pro server: a private CA bundle,
+// a client certificate, or disabled verification. It returns nil when none are configured, leaving Go's defaults.
+func buildTLSConfig(d *schema.ResourceData) (*tls.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	caCertFile := d.Get("ca_cert_file").(string)
+	clientCertFile := d.Get("client_cert_file").(string)
+	clientKeyFile := d.Get("client_key_file").(string)
+	insecureSkipVerify := d.Get("insecure_skip_verify").(bool)
+
+	if caCertFile == "" && clientCertFile == "" && !insecureSkipVerify {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caCertFile != "" {
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("failed to read ca_cert_file '%s': %v", caCertFile, err))
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, diag.FromErr(fmt.Errorf("ca_cert_file '%s' contains no PEM encoded certificates", caCertFile))
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if clientCertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+		if err != nil {
+			return nil, diag.FromErr(fmt.Errorf("failed to load the client certificate from client_cert_file '%s' and client_key_file '%s': %v", clientCertFile, clientKeyFile, err))
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if insecureSkipVerify {
+		tlsConfig.InsecureSkipVerify = true
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "TLS certificate verification is disabled",
+			Detail:   "insecure_skip_verify is set, so the provider accepts any certificate presented for the Jamf Pro server, and API credentials can be intercepted. Use ca_cert_file to trust a private CA instead.",
+		})
+	}
+
+	return tlsConfig, diags
+}
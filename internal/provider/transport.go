@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+)
+
+// rateLimitedTransport is an http.RoundTripper that waits on a shared limiter before sending each request.
+type rateLimitedTransport struct {
+	limiter *rate.Limiter
+	next    http.RoundTripper
+}
+
+// RoundTrip blocks until the limiter allows the request or the request context is cancelled.
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
+
+// concurrencyLimitedTransport is an http.RoundTripper that holds a slot of a shared semaphore for each request
+// from the moment it is sent until its response body is read to the end or closed, so no more than the semaphore's
+// size of requests are in flight at once.
+type concurrencyLimitedTransport struct {
+	semaphore *semaphore.Weighted
+	next      http.RoundTripper
+}
+
+// RoundTrip blocks until a slot is free or the request context is cancelled, then sends the request.
+func (t *concurrencyLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.semaphore.Acquire(req.Context(), 1); err != nil {
+		return nil, err
+	}
+
+	var once sync.Once
+	release := func() { once.Do(func() { t.semaphore.Release(1) }) }
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody calls release once the wrapped response body is exhausted, fails or is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+// Read reads from the body and releases the slot when the body has no more data.
+func (b *releasingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.release()
+	}
+	return n, err
+}
+
+// Close closes the body and releases the slot.
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
+
+// circuitBreakingTransport is an http.RoundTripper that refuses requests while breaker is open and records
+// whether each request reached a working Jamf Pro server. Connection errors and 502, 503 and 504 responses count
+// as failures; any other response shows the server is up.
+type circuitBreakingTransport struct {
+	breaker *jamfclient.CircuitBreaker
+	next    http.RoundTripper
+}
+
+// RoundTrip fails fast while the circuit is open, otherwise sends the request and records its outcome.
+func (t *circuitBreakingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.breaker.Allow(); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if req.Context().Err() != nil {
+		return resp, err
+	}
+
+	t.breaker.Record(err != nil || resp.StatusCode == http.StatusBadGateway ||
+		resp.StatusCode == http.StatusServiceUnavailable || resp.StatusCode == http.StatusGatewayTimeout)
+	return resp, err
+}
+
+// reauthorizingTransport is an http.RoundTripper that replays a request once with a new token when Jamf Pro
+// answers 401 Unauthorized, so a token that expires or is revoked during a long apply does not fail it.
+type reauthorizingTransport struct {
+	integration *serializedTokenIntegration
+	next        http.RoundTripper
+}
+
+// RoundTrip sends the request, and on 401 sends it again with a fresh Authorization header if the body can be replayed.
+func (t *reauthorizingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	rejected := req.Header.Get("Authorization")
+	if rejected == "" || (req.Body != nil && req.GetBody == nil) {
+		return resp, nil
+	}
+
+	authorization, err := t.integration.reauthorize(rejected)
+	if err != nil {
+		return resp, nil
+	}
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	retry.Header.Set("Authorization", authorization)
+
+	resp.Body.Close()
+	return t.next.RoundTrip(retry)
+}
+
+// NewHTTPClient returns the http.Client used for Jamf Pro API requests. Each request fails if Jamf Pro sends no
+// response headers within requestTimeout of the request being written, so a hung request cannot consume the whole
+// retry window; the time spent uploading a request body does not count. The client is limited to requestsPerMinute
+// requests. A value of zero or less for either setting disables that limit. Requests rejected with 401 are replayed
+// once with a token obtained from integration. No more than maxConcurrentRequests requests are in flight at once,
+// unless it is zero or less. When breaker is not nil, requests are refused while it is open. Connections are made
+// by transport, which carries the TLS and proxy settings.
+func NewHTTPClient(requestsPerMinute int, maxConcurrentRequests int, requestTimeout time.Duration, integration *serializedTokenIntegration, breaker *jamfclient.CircuitBreaker, transport *http.Transport) *http.Client {
+	if requestTimeout > 0 {
+		transport.ResponseHeaderTimeout = requestTimeout
+	}
+
+	var roundTripper http.RoundTripper = &reauthorizingTransport{
+		integration: integration,
+		next:        transport,
+	}
+
+	// Requests wait for the rate limiter before taking a slot, so a slot is only held while a request is in flight.
+	if maxConcurrentRequests > 0 {
+		roundTripper = &concurrencyLimitedTransport{
+			semaphore: semaphore.NewWeighted(int64(maxConcurrentRequests)),
+			next:      roundTripper,
+		}
+	}
+
+	if requestsPerMinute > 0 {
+		roundTripper = &rateLimitedTransport{
+			limiter: rate.NewLimiter(rate.Every(time.Minute/time.Duration(requestsPerMinute)), 1),
+			next:    roundTripper,
+		}
+	}
+
+	if breaker != nil {
+		roundTripper = &circuitBreakingTransport{
+			breaker: breaker,
+			next:    roundTripper,
+		}
+	}
+
+	return &http.Client{Transport: roundTripper}
+}
+
+// newTransport returns a copy of the default transport using tlsConfig, if it is not nil, and proxy.
+func newTransport(tlsConfig *tls.Config, proxy func(*http.Request) (*url.URL, error)) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+	transport.Proxy = proxy
+	return transport
+}
@@ -2,17 +2,18 @@
 package accountgroups
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProAccountGroup constructs an AccountGroup object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceAccountGroup, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceAccountGroup, error) {
 	resource := &jamfpro.ResourceAccountGroup{
 		Name:         d.Get("name").(string),
 		AccessLevel:  d.Get("access_level").(string),
@@ -22,9 +23,9 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAccountGroup, error) {
 	resource.Site = sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int))
 	resource.Privileges = constructAccountSubsetPrivileges(d)
 
-	members_ids := d.Get("member_ids").([]interface{})
-	if len(members_ids) > 0 {
-		for _, v := range members_ids {
+	// Membership of directory groups comes from the LDAP server, so members are only sent for local groups.
+	if d.Get("identity_server_id").(int) == 0 {
+		for _, v := range d.Get("member_ids").(*schema.Set).List() {
 			resource.Members = append(resource.Members, jamfpro.MemberUser{ID: v.(int)})
 		}
 	}
@@ -38,7 +39,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAccountGroup, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Account Group '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Account Group XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Account Group XML", string(resourceXML))
 
 	return resource, nil
 }
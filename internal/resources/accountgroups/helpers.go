@@ -20,5 +20,11 @@ func customDiffAccountGroups(ctx context.Context, d *schema.ResourceDiff, meta i
 		}
 	}
 
+	if d.Get("identity_server_id").(int) != 0 {
+		if v, ok := d.GetOk("member_ids"); ok && v.(*schema.Set).Len() > 0 {
+			return fmt.Errorf("'member_ids' cannot be set when 'identity_server_id' is set, as membership of directory groups is managed by the LDAP server")
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,28 @@
+package accountgroups
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAccountGroupsList provides the ID and name of every account group in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProAccountGroupsList() *schema.Resource {
+	return common.ListDataSource("account group", listNames)
+}
+
+// listNames returns the ID and name of every account group in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Groups))
+	for _, item := range response.Groups {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
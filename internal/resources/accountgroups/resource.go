@@ -107,8 +107,8 @@ func ResourceJamfProAccountGroups() *schema.Resource {
 				},
 			},
 			"member_ids": {
-				Type:        schema.TypeList,
-				Description: "Accounts which should be a member of this group by ID",
+				Type:        schema.TypeSet,
+				Description: "Local accounts which should be a member of this group by ID. Not applicable to groups with an identity_server_id, whose membership is managed by the directory.",
 				Optional:    true,
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
@@ -116,7 +116,7 @@ func ResourceJamfProAccountGroups() *schema.Resource {
 			},
 			"identity_server_id": {
 				Type:        schema.TypeInt,
-				Description: "The Id of the identity server",
+				Description: "The Id of the identity (LDAP) server the group is sourced from. Membership of these groups is resolved by Jamf Pro from the directory.",
 				Optional:    true,
 			},
 		},
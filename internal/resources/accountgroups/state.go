@@ -43,7 +43,11 @@ func updateState(d *schema.ResourceData, response *jamfpro.ResourceAccountGroup)
 		return append(diags, diag.FromErr(err)...)
 	}
 
-	if len(response.Members) > 0 {
+	// Members of a directory group are the LDAP users Jamf Pro has resolved from the directory, which
+	// Terraform does not manage, so only local group membership is reconciled.
+	if response.LDAPServer.ID != 0 {
+		d.Set("member_ids", nil)
+	} else if len(response.Members) > 0 {
 		var member_ids []int
 		for _, v := range response.Members {
 			member_ids = append(member_ids, v.ID)
@@ -2,13 +2,27 @@
 package accounts
 
 import (
+	"context"
+	"fmt"
+	"slices"
+
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// validAccessLevels and validPrivilegeSets are the values accepted by the accounts endpoint.
+var (
+	validAccessLevels  = []string{"Full Access", "Site Access", "Group Access"}
+	validPrivilegeSets = []string{"Administrator", "Auditor", "Enrollment Only", "Custom"}
+)
+
 // constructJamfProAccount constructs an Account object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceAccount, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceAccount, error) {
+	if err := validateAccessLevelAndPrivilegeSet(d); err != nil {
+		return nil, err
+	}
+
 	resource := &jamfpro.ResourceAccount{
 		Name:                d.Get("name").(string),
 		DirectoryUser:       d.Get("directory_user").(bool),
@@ -29,6 +43,41 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAccount, error) {
 	return resource, nil
 }
 
+// validateAccessLevelAndPrivilegeSet ensures access_level and privilege_set hold accepted values, and that
+// explicit privilege lists are given when, and only when, privilege_set is Custom. Jamf Pro derives the
+// privileges for the other privilege sets itself.
+func validateAccessLevelAndPrivilegeSet(d *schema.ResourceData) error {
+	name := d.Get("name").(string)
+	accessLevel := d.Get("access_level").(string)
+	privilegeSet := d.Get("privilege_set").(string)
+
+	if !slices.Contains(validAccessLevels, accessLevel) {
+		return fmt.Errorf("account '%s': 'access_level' must be one of %v, got: %s", name, validAccessLevels, accessLevel)
+	}
+
+	if !slices.Contains(validPrivilegeSets, privilegeSet) {
+		return fmt.Errorf("account '%s': 'privilege_set' must be one of %v, got: %s", name, validPrivilegeSets, privilegeSet)
+	}
+
+	hasPrivileges := false
+	for _, key := range privilegeKeys {
+		if v, ok := d.GetOk(key); ok && v.(*schema.Set).Len() > 0 {
+			hasPrivileges = true
+			break
+		}
+	}
+
+	if privilegeSet == "Custom" && !hasPrivileges {
+		return fmt.Errorf("account '%s': at least one privilege list must be set when 'privilege_set' is 'Custom'", name)
+	}
+
+	if privilegeSet != "Custom" && hasPrivileges {
+		return fmt.Errorf("account '%s': privilege lists can only be set when 'privilege_set' is 'Custom', got: %s", name, privilegeSet)
+	}
+
+	return nil
+}
+
 // constructAccountSubsetPrivileges constructs AccountSubsetPrivileges from schema data.
 func constructAccountSubsetPrivileges(d *schema.ResourceData) jamfpro.AccountSubsetPrivileges {
 	privileges := jamfpro.AccountSubsetPrivileges{}
@@ -0,0 +1,28 @@
+package accounts
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAccountsList provides the ID and name of every account in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProAccountsList() *schema.Resource {
+	return common.ListDataSource("account", listNames)
+}
+
+// listNames returns the ID and name of every account in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Users))
+	for _, item := range response.Users {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
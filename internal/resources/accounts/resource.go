@@ -11,6 +11,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// privilegeKeys are the schema keys of the explicit privilege lists, which only apply to the Custom privilege set.
+var privilegeKeys = []string{
+	"jss_objects_privileges",
+	"jss_settings_privileges",
+	"jss_actions_privileges",
+	"casper_admin_privileges",
+	"casper_remote_privileges",
+	"casper_imaging_privileges",
+	"recon_privileges",
+}
+
 // resourceJamfProAccount defines the schema and CRUD operations for managing buildings in Terraform.
 func ResourceJamfProAccounts() *schema.Resource {
 	return &schema.Resource{
@@ -96,13 +107,13 @@ func ResourceJamfProAccounts() *schema.Resource {
 			"password": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The password for the account.",
+				Description: "The password for the account. Write-only: Jamf Pro never returns the password, so changes made outside Terraform are not detected.",
 				Sensitive:   true,
 			},
 			"privilege_set": {
 				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The privilege set assigned to the account.",
+				Required:    true,
+				Description: "The privilege set assigned to the account. Privilege lists may only be set when this is 'Custom'.",
 				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
 					v := val.(string)
 					validPrivileges := []string{"Administrator", "Auditor", "Enrollment Only", "Custom"}
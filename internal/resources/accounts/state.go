@@ -51,6 +51,17 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceAccount) diag.Dia
 		d.Set("site_id", -1)
 	}
 
+	// Jamf Pro reports the full privilege lists for the built-in privilege sets, which would
+	// otherwise show as a diff against the empty lists those sets require in configuration.
+	if resp.PrivilegeSet != "Custom" {
+		for _, key := range privilegeKeys {
+			if err := d.Set(key, nil); err != nil {
+				diags = append(diags, diag.FromErr(err)...)
+			}
+		}
+		return diags
+	}
+
 	if err := d.Set("jss_actions_privileges", resp.Privileges.JSSActions); err != nil {
 		return append(diags, diag.FromErr(err)...)
 	}
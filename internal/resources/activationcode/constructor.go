@@ -2,16 +2,17 @@
 package activationcode
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProActivationCode constructs a ResourceActivationCode object from the provided schema data and logs its XML representation.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceActivationCode, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceActivationCode, error) {
 	resource := &jamfpro.ResourceActivationCode{
 		OrganizationName: d.Get("organization_name").(string),
 		Code:             d.Get("code").(string),
@@ -22,7 +23,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceActivationCode, error)
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Activation Code to XML: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Activation Code XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Activation Code XML", string(resourceXML))
 
 	return resource, nil
 }
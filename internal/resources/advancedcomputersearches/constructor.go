@@ -2,18 +2,23 @@
 package advancedcomputersearches
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProAdvancedComputerSearch constructs an advanced computer search object for create and update operations.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceAdvancedComputerSearch, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceAdvancedComputerSearch, error) {
+	if err := sharedschemas.ValidateCriteriaRegex(d.Get("criteria").([]interface{})); err != nil {
+		return nil, err
+	}
+
 	resource := &jamfpro.ResourceAdvancedComputerSearch{
 		Name:   d.Get("name").(string),
 		ViewAs: d.Get("view_as").(string),
@@ -54,7 +59,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAdvancedComputerSearch,
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Advanced Computer Search '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Advanced Computer Search XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Advanced Computer Search XML", string(resourceXML))
 
 	return resource, nil
 }
@@ -0,0 +1,11 @@
+package advancedcomputersearches
+
+import (
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAdvancedComputerSearchesList provides the ID and name of every advanced computer search in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProAdvancedComputerSearchesList() *schema.Resource {
+	return common.ListDataSource("advanced computer search", listNames)
+}
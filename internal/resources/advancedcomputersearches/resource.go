@@ -21,6 +21,7 @@ func ResourceJamfProAdvancedComputerSearches() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
+		CustomizeDiff: mainCustomDiffFunc,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -5,6 +5,7 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/waitfor"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,10 +18,20 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		meta,
 		construct,
 		meta.(*jamfpro.Client).CreateAdvancedMobileDeviceSearch,
-		readNoCleanup,
+		readAfterCreate,
 	)
 }
 
+// readAfterCreate waits for a newly created Advanced Mobile Device Search to become available before reading it.
+func readAfterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := waitfor.ResourceIsAvailable(ctx, d, "Jamf Pro Advanced Mobile Device Search", d.Id(), meta.(*jamfpro.Client).GetAdvancedMobileDeviceSearchByID, waitfor.Timeout(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, readNoCleanup(ctx, d, meta)...)
+}
+
 // read is responsible for reading the current state of a Jamf Pro mobile device Search from the remote system.
 func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup bool) diag.Diagnostics {
 	return common.Read(
@@ -0,0 +1,29 @@
+package advancedmobiledevicesearches
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc performs plan-time validation of the Jamf Pro Advanced Mobile Device Search resource.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return common.ValidateUniqueName(diff, meta, "advanced mobile device search", listNames)
+}
+
+// listNames returns the ID and name of every advanced mobile device search in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetAdvancedMobileDeviceSearches()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.AdvancedMobileDeviceSearches))
+	for _, item := range response.AdvancedMobileDeviceSearches {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -0,0 +1,11 @@
+package advancedmobiledevicesearches
+
+import (
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAdvancedMobileDeviceSearchesList provides the ID and name of every advanced mobile device search in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProAdvancedMobileDeviceSearchesList() *schema.Resource {
+	return common.ListDataSource("advanced mobile device search", listNames)
+}
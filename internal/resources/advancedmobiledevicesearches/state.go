@@ -5,11 +5,13 @@ import (
 	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// updateState updates the Terraform state with the latest Advanced User Search information from the Jamf Pro API.
+// updateState updates the Terraform state with the latest Advanced Mobile Device Search information from the Jamf Pro API.
 func updateState(d *schema.ResourceData, resp *jamfpro.ResourceAdvancedMobileDeviceSearch) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -17,7 +19,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceAdvancedMobileDev
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	if err := d.Set("name", resp.Name); err != nil {
+	if err := d.Set("name", common.DecodeName(resp.Name)); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
@@ -51,20 +53,28 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceAdvancedMobileDev
 		criteriaList[i] = criteriaMap
 	}
 
-	if err := d.Set("criteria", criteriaList); err != nil {
+	if err := d.Set("criteria", sharedschemas.SortCriteriaByPriority(criteriaList)); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	if len(resp.DisplayFields) > 0 {
-		var displayFieldList []string
-		for _, v := range resp.DisplayFields {
-			displayFieldList = append(displayFieldList, v.Name)
-		}
+	displayFieldList := make([]string, 0, len(resp.DisplayFields))
+	for _, v := range resp.DisplayFields {
+		displayFieldList = append(displayFieldList, v.Name)
+	}
 
-		d.Set("display_fields", displayFieldList)
+	if err := d.Set("display_fields", displayFieldList); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	d.Set("site_id", resp.Site.ID)
+	// Jamf Pro omits the site element for searches that are not assigned to a site.
+	siteID := sharedschemas.EmptySiteId
+	if resp.Site != nil {
+		siteID = resp.Site.ID
+	}
+
+	if err := d.Set("site_id", siteID); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
 
 	return diags
 
@@ -2,17 +2,24 @@
 package advancedusersearches
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProAdvancedUserSearch constructs an advanced user search object for create and update operations.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceAdvancedUserSearch, error) {
+// Updates go through a classic API PUT, where the criteria and display fields sent replace the stored lists,
+// so the whole object is always sent rather than only the fields that changed.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceAdvancedUserSearch, error) {
+	if err := sharedschemas.ValidateCriteriaRegex(d.Get("criteria").([]interface{})); err != nil {
+		return nil, err
+	}
+
 	resource := &jamfpro.ResourceAdvancedUserSearch{
 		Name: d.Get("name").(string),
 	}
@@ -49,7 +56,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAdvancedUserSearch, err
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Advanced User Search '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Advanced User Search XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Advanced User Search XML", string(resourceXML))
 
 	return resource, nil
 }
@@ -2,25 +2,51 @@ package advancedusersearches
 
 import (
 	"context"
+	"sync"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/waitfor"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// createLocks holds a mutex per advanced user search name, so that creates of the same name run one at a time
+// while differently named searches are still created in parallel.
+var createLocks sync.Map
+
+// lockName locks the create mutex for the given name and returns the function that unlocks it.
+func lockName(name string) func() {
+	lock, _ := createLocks.LoadOrStore(name, &sync.Mutex{})
+	mu := lock.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
 // create is responsible for creating a new Jamf Pro advanced user Search in the remote system.
 func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defer lockName(d.Get("name").(string))()
+
 	return common.Create(
 		ctx,
 		d,
 		meta,
 		construct,
 		meta.(*jamfpro.Client).CreateAdvancedUserSearch,
-		readNoCleanup,
+		readAfterCreate,
 	)
 }
 
+// readAfterCreate waits for a newly created advanced user Search to become available before reading it.
+func readAfterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := waitfor.ResourceIsAvailable(ctx, d, "Jamf Pro Advanced User Search", d.Id(), meta.(*jamfpro.Client).GetAdvancedUserSearchByID, waitfor.Timeout(d))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, readNoCleanup(ctx, d, meta)...)
+}
+
 // read is responsible for reading the current state of a Jamf Pro advanced user Search from the remote system.
 func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup bool) diag.Diagnostics {
 	return common.Read(
@@ -57,6 +83,10 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 
 // delete is responsible for deleting a Jamf Pro AdvancedUserSearch.
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := common.CheckDeletionAllowed(d); diags.HasError() {
+		return diags
+	}
+
 	return common.Delete(
 		ctx,
 		d,
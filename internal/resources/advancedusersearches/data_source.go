@@ -4,44 +4,47 @@ package advancedusersearches
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// DataSourceJamfProAdvancedUserSearches provides information about a specific API integration by its ID or Name.
+// DataSourceJamfProAdvancedUserSearches provides information about a specific advanced user search by its ID, or by its Name and optionally its Site.
 func DataSourceJamfProAdvancedUserSearches() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the API integration.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The unique identifier of the advanced user search.",
 			},
 			"name": {
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The name of the advanced user search. Names are only unique within a site, so set site_id when looking up by name if several sites use the same name.",
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
 				Computed:    true,
-				Description: "The unique name of the advanced mobile user search.",
+				Description: "The ID of the site the advanced user search belongs to, or -1 for none. When set with name, only searches in this site match.",
 			},
 		},
 	}
 }
 
-// dataSourceRead fetches the details of a specific API integration
-// from Jamf Pro using either its unique Name or its Id. The function prioritizes the 'display_name' attribute over the 'id'
-// attribute for fetching details. If neither 'display_name' nor 'id' is provided, it returns an error.
+// dataSourceRead fetches the details of a specific advanced user search from Jamf Pro by its ID, or by
+// its name filtered by site_id when provided. A name lookup that matches more than one search is an error.
 // Once the details are fetched, they are set in the data source's state.
-//
-// Parameters:
-// - ctx: The context within which the function is called. It's used for timeouts and cancellation.
-// - d: The current state of the data source.
-// - meta: The meta object that can be used to retrieve the API client connection.
-//
-// Returns:
-// - diag.Diagnostics: Returns any diagnostics (errors or warnings) encountered during the function's execution.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, ok := meta.(*jamfpro.Client)
 	if !ok {
@@ -51,13 +54,21 @@ func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{
 	var diags diag.Diagnostics
 	resourceID := d.Get("id").(string)
 
+	if resourceID == "" {
+		var err error
+		resourceID, err = findAdvancedUserSearchID(ctx, d, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var resource *jamfpro.ResourceAdvancedUserSearch
 
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
 		resource, apiErr = client.GetAdvancedUserSearchByID(resourceID)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return jamfclient.RetryError(apiErr)
 		}
 		return nil
 	})
@@ -71,9 +82,73 @@ func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{
 		if err := d.Set("name", resource.Name); err != nil {
 			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Advanced User Search with ID '%s': %v", resourceID, err))...)
 		}
+		if err := d.Set("site_id", searchSiteID(resource)); err != nil {
+			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'site_id' for Jamf Pro Advanced User Search with ID '%s': %v", resourceID, err))...)
+		}
 	} else {
 		d.SetId("")
 	}
 
 	return diags
 }
+
+// findAdvancedUserSearchID resolves the configured name, and site_id if set, to the ID of exactly one advanced user search.
+func findAdvancedUserSearchID(ctx context.Context, d *schema.ResourceData, client *jamfpro.Client) (string, error) {
+	name := d.Get("name").(string)
+	siteID, filterBySite := d.GetOk("site_id")
+
+	var matches []string
+	var matchSites []string
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		matches, matchSites = nil, nil
+
+		searches, apiErr := listNames(client)
+		if apiErr != nil {
+			return jamfclient.RetryError(apiErr)
+		}
+
+		for _, search := range searches {
+			if search.Name != name {
+				continue
+			}
+
+			resource, apiErr := client.GetAdvancedUserSearchByID(search.ID)
+			if apiErr != nil {
+				return jamfclient.RetryError(apiErr)
+			}
+
+			site := searchSiteID(resource)
+			if filterBySite && site != siteID.(int) {
+				continue
+			}
+
+			matches = append(matches, search.ID)
+			matchSites = append(matchSites, fmt.Sprintf("ID %s in site %d", search.ID, site))
+		}
+		return nil
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("failed to look up Jamf Pro Advanced User Search named '%s' after retries: %v", name, err)
+	}
+
+	switch len(matches) {
+	case 0:
+		if filterBySite {
+			return "", fmt.Errorf("no Jamf Pro Advanced User Search named '%s' found in site %d", name, siteID.(int))
+		}
+		return "", fmt.Errorf("no Jamf Pro Advanced User Search named '%s' found", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("found %d Jamf Pro Advanced User Searches named '%s' (%s); set site_id or id to select one", len(matches), name, strings.Join(matchSites, ", "))
+	}
+}
+
+// searchSiteID returns the ID of the site an advanced user search belongs to, or -1 if it has none.
+func searchSiteID(resource *jamfpro.ResourceAdvancedUserSearch) int {
+	if resource.Site == nil || resource.Site.ID == 0 {
+		return -1
+	}
+	return resource.Site.ID
+}
@@ -0,0 +1,29 @@
+package advancedusersearches
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc performs plan-time validation of the Jamf Pro Advanced User Search resource.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return common.ValidateUniqueName(diff, meta, "advanced user search", listNames)
+}
+
+// listNames returns the ID and name of every advanced user search in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetAdvancedUserSearches()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.AdvancedUserSearch))
+	for _, item := range response.AdvancedUserSearch {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
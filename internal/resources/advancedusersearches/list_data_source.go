@@ -0,0 +1,11 @@
+package advancedusersearches
+
+import (
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAdvancedUserSearchesList provides the ID and name of every advanced user search in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProAdvancedUserSearchesList() *schema.Resource {
+	return common.ListDataSource("advanced user search", listNames)
+}
@@ -21,6 +21,7 @@ func ResourceJamfProAdvancedUserSearches() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
+		CustomizeDiff: mainCustomDiffFunc,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -35,6 +36,7 @@ func ResourceJamfProAdvancedUserSearches() *schema.Resource {
 				Required:    true,
 				Description: "The name of the advanced mobile device search",
 			},
+			"allow_deletion": sharedschemas.GetSharedSchemaAllowDeletion(),
 			"criteria": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -0,0 +1,136 @@
+// advancedusersearches_results_data_source.go
+package advancedusersearches
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAdvancedUserSearchResults runs an advanced user search, selected by its ID or by its Name
+// and optionally its Site, and provides the users it matches.
+func DataSourceJamfProAdvancedUserSearchResults() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceResultsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The unique identifier of the advanced user search.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The name of the advanced user search. Set site_id as well if several sites use the same name.",
+			},
+			"site_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the site the advanced user search belongs to, or -1 for none. When set with name, only searches in this site match.",
+			},
+			"user_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of users matched by the search.",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The users matched by the search.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the user.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The full name of the user.",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The username of the user.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceResultsRead fetches an advanced user search from Jamf Pro, which evaluates its criteria on
+// every request, and states the users it currently matches. The Classic API returns every result in a
+// single response, so no paging is needed.
+func dataSourceResultsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.client")
+	}
+
+	var diags diag.Diagnostics
+	resourceID := d.Get("id").(string)
+
+	if resourceID == "" {
+		var err error
+		resourceID, err = findAdvancedUserSearchID(ctx, d, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var resource *jamfpro.ResourceAdvancedUserSearch
+
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		resource, apiErr = client.GetAdvancedUserSearchByID(resourceID)
+		if apiErr != nil {
+			return jamfclient.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to run Jamf Pro Advanced User Search with ID '%s' after retries: %v", resourceID, err))
+	}
+
+	users := make([]interface{}, 0)
+	for _, container := range resource.Users {
+		for _, user := range container.User {
+			users = append(users, map[string]interface{}{
+				"id":       strconv.Itoa(user.ID),
+				"name":     user.Name,
+				"username": user.Username,
+			})
+		}
+	}
+
+	d.SetId(resourceID)
+	if err := d.Set("name", resource.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("site_id", searchSiteID(resource)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("user_count", len(users)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("users", users); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
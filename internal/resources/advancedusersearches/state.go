@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,7 +19,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceAdvancedUserSearc
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
-	if err := d.Set("name", resp.Name); err != nil {
+	if err := d.Set("name", common.DecodeName(resp.Name)); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
@@ -35,7 +37,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceAdvancedUserSearc
 		criteriaList[i] = criteriaMap
 	}
 
-	if err := d.Set("criteria", criteriaList); err != nil {
+	if err := d.Set("criteria", sharedschemas.SortCriteriaByPriority(criteriaList)); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
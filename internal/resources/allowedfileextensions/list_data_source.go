@@ -0,0 +1,28 @@
+package allowedfileextensions
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProAllowedFileExtensionsList provides the ID and name of every allowed file extension in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProAllowedFileExtensionsList() *schema.Resource {
+	return common.ListDataSource("allowed file extension", listNames)
+}
+
+// listNames returns the ID and name of every allowed file extension in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetAllowedFileExtensions()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.AllowedFileExtensions))
+	for _, item := range response.AllowedFileExtensions {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Extension})
+	}
+	return objects, nil
+}
@@ -0,0 +1,28 @@
+package apiintegrations
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProApiIntegrationsList provides the ID and name of every API integration in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProApiIntegrationsList() *schema.Resource {
+	return common.ListDataSource("API integration", listNames)
+}
+
+// listNames returns the ID and name of every API integration in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetApiIntegrations("")
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Results))
+	for _, item := range response.Results {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.DisplayName})
+	}
+	return objects, nil
+}
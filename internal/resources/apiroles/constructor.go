@@ -1,16 +1,17 @@
 package apiroles
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProApiRole constructs an ResourceAPIRole object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceAPIRole, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceAPIRole, error) {
 	resource := &jamfpro.ResourceAPIRole{
 		DisplayName: d.Get("display_name").(string),
 	}
@@ -32,7 +33,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceAPIRole, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Api Role '%s' to JSON: %v", resource.DisplayName, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Api Role JSON:\n%s\n", string(resourceJSON))
+	common.LogConstructedPayload(ctx, "Jamf Pro Api Role JSON", string(resourceJSON))
 
 	return resource, nil
 }
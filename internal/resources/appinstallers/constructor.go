@@ -1,12 +1,14 @@
 package appinstallers
 
 import (
+	"context"
 	"embed"
 	"encoding/json"
 	"fmt"
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -40,7 +42,7 @@ func getAppTitleID(name string) (string, error) {
 }
 
 // construct constructs an AppCatalogDeployment object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceJamfAppCatalogDeployment, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceJamfAppCatalogDeployment, error) {
 	name := d.Get("name").(string)
 	appTitleID, err := getAppTitleID(name)
 	if err != nil {
@@ -104,7 +106,12 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceJamfAppCatalogDeploymen
 		return nil, fmt.Errorf("failed to marshal Jamf Pro App Installer Deployment '%s' to JSON: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro App Installer Deployment JSON:\n%s\n", string(resourceJSON))
+	common.LogConstructedPayload(ctx, "Jamf Pro App Installer Deployment JSON", string(resourceJSON))
 
 	return resource, nil
 }
+
+// Render returns the app installer deployment payload built from d without calling Jamf Pro.
+func Render(ctx context.Context, d *schema.ResourceData) (interface{}, error) {
+	return construct(ctx, d)
+}
@@ -3,10 +3,13 @@ package appinstallers
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/waitfor"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,7 +38,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		return diag.FromErr(fmt.Errorf("failed to ensure Jamf Pro App Installer terms and conditions are accepted: %v", err))
 	}
 
-	resource, err := construct(d)
+	resource, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro App Installer: %v", err))
 	}
@@ -45,7 +48,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		var apiErr error
 		creationResponse, apiErr = client.CreateJamfAppCatalogAppInstallerDeployment(resource)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			return jamfclient.RetryError(apiErr)
 		}
 		return nil
 	})
@@ -54,9 +57,23 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro App Installer '%s' after retries: %v", resource.Name, err))
 	}
 
+	// The deployment now exists in Jamf Pro. Record its ID straight away and keep the rest of the state empty
+	// until it has been read back, so a failure below leaves a tainted resource that the next apply replaces,
+	// rather than no state at all and an orphaned deployment.
 	d.SetId(creationResponse.ID)
+	d.Partial(true)
 
-	return append(diags, readNoCleanup(ctx, d, meta)...)
+	diags = append(diags, waitfor.ResourceIsAvailable(ctx, d, "Jamf Pro App Installer", d.Id(), client.GetJamfAppCatalogAppInstallerDeploymentByID, waitfor.Timeout(d))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags = append(diags, readNoCleanup(ctx, d, meta)...)
+	if !diags.HasError() {
+		d.Partial(false)
+	}
+
+	return diags
 }
 
 // read reads and states a jamfpro building
@@ -70,7 +87,10 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup
 		var apiErr error
 		response, apiErr = client.GetJamfAppCatalogAppInstallerDeploymentByID(resourceID)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			if !cleanup && jamfclient.StatusCode(apiErr) == http.StatusNotFound {
+				return retry.RetryableError(apiErr)
+			}
+			return jamfclient.RetryError(apiErr)
 		}
 		return nil
 	})
@@ -92,16 +112,39 @@ func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}
 	return read(ctx, d, meta, false)
 }
 
-// update updates a jamfpro building
+// update updates a jamfpro app installer. The previous state is kept if the update is rejected or does not
+// apply in time, so the next plan still shows the pending change.
 func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return common.Update(
+	d.Partial(true)
+
+	diags := common.Update(
 		ctx,
 		d,
 		meta,
 		construct,
 		meta.(*jamfpro.Client).UpdateJamfAppCatalogAppInstallerDeploymentByID,
-		readNoCleanup,
+		readAfterUpdate,
 	)
+	if !diags.HasError() {
+		d.Partial(false)
+	}
+
+	return diags
+}
+
+// readAfterUpdate waits for the deployment to reflect the update before reading it, so the state is not
+// populated from a deployment that is still transitioning.
+func readAfterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	payload, err := construct(ctx, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro App Installer: %v", err))
+	}
+
+	if err := waitForDeploymentUpdate(ctx, meta.(*jamfpro.Client), d.Id(), payload, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed waiting for Jamf Pro App Installer '%s' (ID: %s) to apply the update: %v", payload.Name, d.Id(), err))
+	}
+
+	return readNoCleanup(ctx, d, meta)
 }
 
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,75 @@
+package appinstallers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestCreateKeepsIDWhenReadFails checks that a deployment created in Jamf Pro stays in state when the
+// steps after the create call fail, so that it is not orphaned.
+func TestCreateKeepsIDWhenReadFails(t *testing.T) {
+	const deploymentPath = "/api/v1/app-installers/deployments/42"
+
+	cases := []struct {
+		name      string
+		responses []jamfmock.Response
+	}{
+		{name: "not readable after create", responses: []jamfmock.Response{jamfmock.JSON(http.StatusForbidden, `{}`)}},
+		{name: "still failing when the context expires", responses: []jamfmock.Response{jamfmock.JSON(http.StatusInternalServerError, `{}`)}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := jamfmock.NewServer()
+			defer server.Close()
+			server.Handle(http.MethodGet, termsPath, jamfmock.JSON(http.StatusOK, `{"accepted":true}`))
+			server.Handle(http.MethodPost, "/api/v1/app-installers/deployments", jamfmock.JSON(http.StatusCreated, `{"id":"42","href":"/api/v1/app-installers/deployments/42"}`))
+			server.Handle(http.MethodGet, deploymentPath, tc.responses...)
+
+			client, err := server.Client()
+			if err != nil {
+				t.Fatalf("building mock client: %v", err)
+			}
+
+			resource := ResourceJamfProAppInstallers()
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":            appTitles.Results[0].TitleName,
+				"enabled":         true,
+				"deployment_type": "SELF_SERVICE",
+				"update_behavior": "AUTOMATIC",
+				"category_id":     "-1",
+				"site_id":         "-1",
+				"smart_group_id":  "1",
+			})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			defer cancel()
+
+			diff, err := resource.Diff(ctx, nil, config, client)
+			if err != nil {
+				t.Fatalf("planning: %v", err)
+			}
+
+			state, diags := resource.Apply(ctx, nil, diff, client)
+
+			if !diags.HasError() {
+				t.Fatal("create succeeded, want the failed read to be reported")
+			}
+			if state == nil || state.ID != "42" {
+				t.Fatalf("got state %v after a failure following create, want the created deployment's ID kept", state)
+			}
+			// The planned values were never read back, so they are not recorded as applied.
+			if got := state.Attributes["deployment_type"]; got != "" {
+				t.Fatalf("got deployment_type %q in state, want it left unset until the deployment is read", got)
+			}
+			if got := len(server.Requests(http.MethodPost, "/api/v1/app-installers/deployments")); got != 1 {
+				t.Fatalf("got %d create requests, want 1", got)
+			}
+		})
+	}
+}
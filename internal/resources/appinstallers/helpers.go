@@ -6,9 +6,16 @@ import (
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
+// termsAndConditionsTimeout bounds how long to wait for an acceptance of the terms and conditions to be
+// reflected in their status.
+const termsAndConditionsTimeout = 10 * time.Second
+
 // checkJamfAppCatalogAppInstallerTermsAndConditions checks and accepts the terms and conditions for
 // the Jamf App Catalog App Installer if it not enabled. this is required per account trying to interact
 // with the Jamf App Catalog App Installer.
@@ -17,7 +24,7 @@ func checkJamfAppCatalogAppInstallerTermsAndConditions(ctx context.Context, clie
 	if err != nil {
 		return fmt.Errorf("failed to fetch Jamf Pro App Installer terms and conditions status: %v", err)
 	}
-	fmt.Printf("Fetched Terms and Conditions Status: %+v\n", status)
+	tflog.Debug(ctx, "Fetched Jamf Pro App Installer terms and conditions status", map[string]interface{}{"accepted": status.Accepted})
 
 	// If terms and conditions are already accepted, no further action is needed
 	if status.Accepted {
@@ -29,20 +36,75 @@ func checkJamfAppCatalogAppInstallerTermsAndConditions(ctx context.Context, clie
 		return fmt.Errorf("failed to accept Jamf Pro App Installer terms and conditions: %v", err)
 	}
 
-	const maxRetries = 5
-	var retryInterval = 2 * time.Second
+	ctx, cancel := context.WithTimeout(ctx, termsAndConditionsTimeout)
+	defer cancel()
 
-	return retry.RetryContext(ctx, time.Duration(maxRetries)*retryInterval, func() *retry.RetryError {
+	backoff := common.Backoff{Base: 1 * time.Second, Cap: 4 * time.Second, Jitter: 0.1}
+	for attempt := 0; ; attempt++ {
 		status, err := client.GetJamfAppCatalogAppInstallerTermsAndConditionsStatus()
+		switch {
+		case err != nil && !jamfclient.IsRetryable(err):
+			return fmt.Errorf("failed to fetch Jamf Pro App Installer terms and conditions status: %v", err)
+		case err != nil:
+			err = fmt.Errorf("failed to fetch Jamf Pro App Installer terms and conditions status: %v", err)
+		case status.Accepted:
+			return nil
+		default:
+			err = fmt.Errorf("terms and conditions are not yet accepted")
+		}
+
+		delay := backoff.Delay(attempt)
+		tflog.Debug(ctx, "Waiting for Jamf Pro App Installer terms and conditions to be accepted", map[string]interface{}{"reason": err.Error(), "retry_in": delay.String()})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("Jamf Pro App Installer terms and conditions were not accepted within %s: %v", termsAndConditionsTimeout, err)
+		case <-time.After(delay):
+		}
+	}
+}
+
+// deploymentStatus summarises the state of an app installer deployment for the computed status attribute.
+func deploymentStatus(resp *jamfpro.ResourceJamfAppCatalogDeployment) string {
+	switch {
+	case resp.VersionRemoved != nil && *resp.VersionRemoved:
+		return "VERSION_REMOVED"
+	case resp.Enabled != nil && !*resp.Enabled:
+		return "DISABLED"
+	case resp.LatestAvailableVersion != "" && resp.SelectedVersion != resp.LatestAvailableVersion:
+		return "UPDATE_AVAILABLE"
+	default:
+		return "ACTIVE"
+	}
+}
+
+// deploymentMatchesPayload reports whether a deployment read back from Jamf Pro reflects the settings that were sent.
+func deploymentMatchesPayload(resp, payload *jamfpro.ResourceJamfAppCatalogDeployment) bool {
+	return resp.Name == payload.Name &&
+		boolValue(resp.Enabled) == boolValue(payload.Enabled) &&
+		resp.DeploymentType == payload.DeploymentType &&
+		resp.UpdateBehavior == payload.UpdateBehavior &&
+		resp.CategoryId == payload.CategoryId &&
+		resp.SiteId == payload.SiteId &&
+		resp.SmartGroupId == payload.SmartGroupId
+}
+
+// boolValue dereferences an optional bool, treating nil as false.
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+// waitForDeploymentUpdate polls the deployment until it reflects payload, as Jamf Pro can briefly
+// return the previous settings after an update is accepted.
+func waitForDeploymentUpdate(ctx context.Context, client *jamfpro.Client, resourceID string, payload *jamfpro.ResourceJamfAppCatalogDeployment, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		resp, err := client.GetJamfAppCatalogAppInstallerDeploymentByID(resourceID)
 		if err != nil {
-			return retry.RetryableError(fmt.Errorf("failed to fetch Jamf Pro App Installer terms and conditions status: %v", err))
+			return jamfclient.RetryError(err)
 		}
-		fmt.Printf("Retry Fetched Terms and Conditions Status: %+v\n", status)
 
-		if !status.Accepted {
-			time.Sleep(retryInterval)
-			retryInterval *= 2
-			return retry.RetryableError(fmt.Errorf("terms and conditions are not yet accepted"))
+		if !deploymentMatchesPayload(resp, payload) {
+			return retry.RetryableError(fmt.Errorf("app installer deployment '%s' (ID: %s) has not yet applied the update", payload.Name, resourceID))
 		}
 
 		return nil
@@ -0,0 +1,95 @@
+package appinstallers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfmock"
+)
+
+const (
+	termsPath       = "/api/v1/app-installers/terms-and-conditions"
+	acceptTermsPath = "/api/v1/app-installers/terms-and-conditions/accept"
+)
+
+func TestCheckTermsAndConditions(t *testing.T) {
+	accepted := jamfmock.JSON(http.StatusOK, `{"accepted":true,"acceptanceTime":"2024-01-01T00:00:00Z"}`)
+	notAccepted := jamfmock.JSON(http.StatusOK, `{"accepted":false}`)
+
+	cases := []struct {
+		name        string
+		statuses    []jamfmock.Response
+		wantAccepts int
+		wantErr     bool
+		maxDuration time.Duration
+	}{
+		{
+			name:        "already accepted",
+			statuses:    []jamfmock.Response{accepted},
+			maxDuration: time.Second,
+		},
+		{
+			name:        "accepted on first check",
+			statuses:    []jamfmock.Response{notAccepted, accepted},
+			wantAccepts: 1,
+			maxDuration: time.Second,
+		},
+		{
+			name:        "accepted after a transient failure",
+			statuses:    []jamfmock.Response{notAccepted, jamfmock.JSON(http.StatusServiceUnavailable, `{}`), accepted},
+			wantAccepts: 1,
+			maxDuration: 3 * time.Second,
+		},
+		{
+			name:        "permanent failure is not retried",
+			statuses:    []jamfmock.Response{notAccepted, jamfmock.JSON(http.StatusForbidden, `{}`)},
+			wantAccepts: 1,
+			wantErr:     true,
+			maxDuration: time.Second,
+		},
+		{
+			name:        "never accepted",
+			statuses:    []jamfmock.Response{notAccepted},
+			wantAccepts: 1,
+			wantErr:     true,
+			maxDuration: termsAndConditionsTimeout + 2*time.Second,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.name == "never accepted" && testing.Short() {
+				t.Skip("waits for the full terms and conditions timeout")
+			}
+
+			server := jamfmock.NewServer()
+			defer server.Close()
+			server.Handle(http.MethodGet, termsPath, tc.statuses...)
+			server.Handle(http.MethodPost, acceptTermsPath, accepted)
+
+			client, err := server.Client()
+			if err != nil {
+				t.Fatalf("building mock client: %v", err)
+			}
+
+			start := time.Now()
+			err = checkJamfAppCatalogAppInstallerTermsAndConditions(context.Background(), client)
+			elapsed := time.Since(start)
+
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tc.wantErr)
+			}
+			if got := len(server.Requests(http.MethodPost, acceptTermsPath)); got != tc.wantAccepts {
+				t.Fatalf("got %d accept requests, want %d", got, tc.wantAccepts)
+			}
+			if elapsed > tc.maxDuration {
+				t.Fatalf("took %v, want at most %v", elapsed, tc.maxDuration)
+			}
+			if tc.name == "never accepted" && elapsed < termsAndConditionsTimeout {
+				t.Fatalf("gave up after %v, want to wait the full %v", elapsed, termsAndConditionsTimeout)
+			}
+		})
+	}
+}
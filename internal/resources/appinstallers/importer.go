@@ -0,0 +1,77 @@
+package appinstallers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// uriAppInstallerDeployments lists app installer deployments. The SDK has no list call for them.
+const uriAppInstallerDeployments = "/api/v1/app-installers/deployments"
+
+// importState accepts either a deployment ID or an app title name, such as "Google Chrome". A title name is
+// resolved to the ID of the single deployment of that title.
+func importState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	if _, err := strconv.Atoi(importID); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	appTitleID, err := getAppTitleID(importID)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is neither a Jamf Pro App Installer ID nor a known app title: %v", importID, err)
+	}
+
+	deployments, err := listDeployments(meta.(*jamfpro.Client))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Jamf Pro App Installers to resolve '%s': %v", importID, err)
+	}
+
+	var matches []string
+	for _, deployment := range deployments {
+		if deployment.AppTitleId == appTitleID {
+			matches = append(matches, deployment.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no Jamf Pro App Installer is deployed for app title '%s'", importID)
+	case 1:
+		d.SetId(matches[0])
+		return []*schema.ResourceData{d}, nil
+	default:
+		return nil, fmt.Errorf("found %d Jamf Pro App Installers for app title '%s' (IDs: %s); import one of them by ID", len(matches), importID, strings.Join(matches, ", "))
+	}
+}
+
+// listDeployments returns the ID and app title ID of every app installer deployment in Jamf Pro.
+func listDeployments(client *jamfpro.Client) ([]jamfpro.ResourceJamfAppCatalogDeployment, error) {
+	const pageSize = 200
+
+	var deployments []jamfpro.ResourceJamfAppCatalogDeployment
+	for page := 0; ; page++ {
+		var response struct {
+			TotalCount int                                        `json:"totalCount"`
+			Results    []jamfpro.ResourceJamfAppCatalogDeployment `json:"results"`
+		}
+
+		endpoint := fmt.Sprintf("%s?page=%d&page-size=%d", uriAppInstallerDeployments, page, pageSize)
+		resp, err := client.HTTP.DoRequest("GET", endpoint, nil, &response)
+		if resp != nil && resp.Body != nil {
+			resp.Body.Close()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		deployments = append(deployments, response.Results...)
+		if len(response.Results) < pageSize || len(deployments) >= response.TotalCount {
+			return deployments, nil
+		}
+	}
+}
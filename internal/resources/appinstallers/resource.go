@@ -20,7 +20,7 @@ func ResourceJamfProAppInstallers() *schema.Resource {
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importState,
 		},
 		CustomizeDiff: validateAppCatalogDeploymentName,
 
@@ -201,6 +201,11 @@ func ResourceJamfProAppInstallers() *schema.Resource {
 				Computed:    true,
 				Description: "Whether the version has been removed.",
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current state of the deployment, derived from the deployment settings: VERSION_REMOVED if the selected version was removed from the catalog, DISABLED if the deployment is disabled, UPDATE_AVAILABLE if a newer version than the selected version is available, otherwise ACTIVE.",
+			},
 		},
 	}
 }
@@ -26,6 +26,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceJamfAppCatalogDep
 		"selected_version":                   resp.SelectedVersion,
 		"latest_available_version":           resp.LatestAvailableVersion,
 		"version_removed":                    resp.VersionRemoved,
+		"status":                             deploymentStatus(resp),
 	}
 
 	for key, val := range deploymentData {
@@ -0,0 +1,26 @@
+package buildings
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProBuildingsList provides the ID and name of every building in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProBuildingsList() *schema.Resource {
+	return common.ListDataSource("building", listNames)
+}
+
+// listNames returns the ID and name of every building in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetBuildings("")
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Results))
+	for _, item := range response.Results {
+		objects = append(objects, common.NamedObject{ID: item.ID, Name: item.Name})
+	}
+	return objects, nil
+}
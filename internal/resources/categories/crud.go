@@ -22,7 +22,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	// Lock the mutex to ensure only one profile plust create can run this function at a time
 	mu.Lock()
 	defer mu.Unlock()
-	resource, err := construct(d)
+	resource, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile: %v", err))
 	}
@@ -80,8 +80,16 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	)
 }
 
-// delete is responsible for deleting a Jamf Pro Category.
+// delete is responsible for deleting a Jamf Pro Category. Policies, scripts or packages still assigned
+// the category are reported by name rather than leaving Jamf Pro to reject the delete.
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	if diags := common.CheckNotReferenced(ctx, d, "category", func() ([]common.Reference, error) {
+		return findReferences(client, d.Id(), d.Get("name").(string))
+	}); diags.HasError() {
+		return diags
+	}
+
 	return common.Delete(
 		ctx,
 		d,
@@ -0,0 +1,26 @@
+package categories
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProCategoriesList provides the ID and name of every category in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProCategoriesList() *schema.Resource {
+	return common.ListDataSource("category", listNames)
+}
+
+// listNames returns the ID and name of every category in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetCategories("")
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Results))
+	for _, item := range response.Results {
+		objects = append(objects, common.NamedObject{ID: item.Id, Name: item.Name})
+	}
+	return objects, nil
+}
@@ -0,0 +1,44 @@
+package categories
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+)
+
+// findReferences returns the policies, scripts and packages that are assigned the category.
+func findReferences(jamfClient *jamfpro.Client, id string, name string) ([]common.Reference, error) {
+	var references []common.Reference
+
+	policies, err := jamfClient.GetPolicyByCategory(url.PathEscape(name))
+	if err != nil && client.StatusCode(err) != http.StatusNotFound {
+		return nil, fmt.Errorf("failed to list policies in category '%s': %v", name, err)
+	}
+	if policies != nil {
+		for _, policy := range policies.Policy {
+			references = append(references, common.Reference{Type: "policy", Name: policy.Name})
+		}
+	}
+
+	scripts, err := jamfClient.GetScripts("&filter=" + url.QueryEscape(fmt.Sprintf("categoryId==%q", id)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scripts in category '%s': %v", name, err)
+	}
+	for _, script := range scripts.Results {
+		references = append(references, common.Reference{Type: "script", Name: script.Name})
+	}
+
+	packages, err := jamfClient.GetPackages("", fmt.Sprintf("categoryId==%q", id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list packages in category '%s': %v", name, err)
+	}
+	for _, pkg := range packages.Results {
+		references = append(references, common.Reference{Type: "package", Name: pkg.PackageName})
+	}
+
+	return references, nil
+}
@@ -0,0 +1,85 @@
+package classes
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"sort"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct constructs a ResourceClass object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceClass, error) {
+	resource := &jamfpro.ResourceClass{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Site:        jamfpro.SharedResourceSite{ID: d.Get("site_id").(int)},
+	}
+
+	for _, student := range getSortedStrings(d, "students") {
+		resource.Students = append(resource.Students, jamfpro.ClassSubsetStudent{Student: student})
+	}
+
+	for _, teacher := range getSortedStrings(d, "teachers") {
+		resource.Teachers = append(resource.Teachers, jamfpro.ClassSubsetTeacher{Teacher: teacher})
+	}
+
+	for _, id := range getSortedInts(d, "teacher_ids") {
+		resource.TeacherIDs = append(resource.TeacherIDs, jamfpro.ClassSubsetTeacherIDs{ID: id})
+	}
+
+	for _, id := range getSortedInts(d, "student_group_ids") {
+		resource.StudentGroupIDs = append(resource.StudentGroupIDs, jamfpro.ClassSubsetStudentGroupIDs{ID: id})
+	}
+
+	for _, id := range getSortedInts(d, "teacher_group_ids") {
+		resource.TeacherGroupIDs = append(resource.TeacherGroupIDs, jamfpro.ClassSubsetTeacherGroupIDs{ID: id})
+	}
+
+	for _, id := range getSortedInts(d, "mobile_device_group_ids") {
+		resource.MobileDeviceGroupID = append(resource.MobileDeviceGroupID, jamfpro.ClassSubsetMobileDeviceGroupID{ID: id})
+	}
+
+	if v, ok := d.GetOk("meeting_times"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		meetingTime := v.([]interface{})[0].(map[string]interface{})
+		resource.MeetingTimes = jamfpro.ClassContainerMeetingTimes{
+			MeetingTime: jamfpro.ClassSubsetMeetingTime{
+				Days:      meetingTime["days"].(string),
+				StartTime: meetingTime["start_time"].(int),
+				EndTime:   meetingTime["end_time"].(int),
+			},
+		}
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Class '%s' to XML: %v", resource.Name, err)
+	}
+
+	common.LogConstructedPayload(ctx, "Jamf Pro Class XML", string(resourceXML))
+
+	return resource, nil
+}
+
+// getSortedStrings returns the members of a string set attribute in a stable order.
+func getSortedStrings(d *schema.ResourceData, key string) []string {
+	var out []string
+	for _, v := range d.Get(key).(*schema.Set).List() {
+		out = append(out, v.(string))
+	}
+	sort.Strings(out)
+	return out
+}
+
+// getSortedInts returns the members of an int set attribute in a stable order.
+func getSortedInts(d *schema.ResourceData, key string) []int {
+	var out []int
+	for _, v := range d.Get(key).(*schema.Set).List() {
+		out = append(out, v.(int))
+	}
+	sort.Ints(out)
+	return out
+}
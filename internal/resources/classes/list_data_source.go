@@ -0,0 +1,28 @@
+package classes
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProClassesList provides the ID and name of every class in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProClassesList() *schema.Resource {
+	return common.ListDataSource("class", listNames)
+}
+
+// listNames returns the ID and name of every class in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetClasses()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Classes))
+	for _, item := range response.Classes {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -0,0 +1,127 @@
+package classes
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProClasses defines the schema and CRUD operations for managing Jamf Pro Classes in Terraform.
+func ResourceJamfProClasses() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the class.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the class.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the class.",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The source of the class, e.g. 'N/A' for classes created in Jamf Pro or 'Apple School Manager'.",
+			},
+			"site_id": sharedschemas.GetSharedSchemaSite(),
+			"students": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The usernames of the students in the class.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			// Jamf Pro reports teachers by both username and ID regardless of how they were
+			// assigned, so both attributes are computed to avoid a diff on the one left unset.
+			"teachers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "The usernames of the teachers of the class.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"teacher_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "The IDs of the Jamf Pro users who teach the class.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"student_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the user groups whose members are students in the class.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"teacher_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the user groups whose members teach the class.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"mobile_device_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the mobile device groups assigned to the class.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"meeting_times": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The meeting times of the class.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The days the class meets, as a space separated list of day initials, e.g. 'M W F'.",
+						},
+						"start_time": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateMeetingTime,
+							Description:  "The time the class starts, in 24 hour HHMM format, e.g. 900 for 09:00.",
+						},
+						"end_time": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateMeetingTime,
+							Description:  "The time the class ends, in 24 hour HHMM format, e.g. 1530 for 15:30.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateMeetingTime ensures a meeting time is a valid 24 hour HHMM value.
+func validateMeetingTime(v interface{}, k string) (warns []string, errs []error) {
+	value := v.(int)
+	if value < 0 || value > 2359 || value%100 > 59 {
+		errs = append(errs, fmt.Errorf("%q must be a 24 hour time in HHMM format between 0 and 2359, got: %d", k, value))
+	}
+	return
+}
@@ -0,0 +1,91 @@
+package classes
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Class information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceClass) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var students []string
+	for _, student := range resp.Students {
+		if student.Student != "" {
+			students = append(students, student.Student)
+		}
+	}
+
+	var teachers []string
+	for _, teacher := range resp.Teachers {
+		if teacher.Teacher != "" {
+			teachers = append(teachers, teacher.Teacher)
+		}
+	}
+
+	var teacherIDs []int
+	for _, teacher := range resp.TeacherIDs {
+		if teacher.ID != 0 {
+			teacherIDs = append(teacherIDs, teacher.ID)
+		}
+	}
+
+	var studentGroupIDs []int
+	for _, group := range resp.StudentGroupIDs {
+		if group.ID != 0 {
+			studentGroupIDs = append(studentGroupIDs, group.ID)
+		}
+	}
+
+	var teacherGroupIDs []int
+	for _, group := range resp.TeacherGroupIDs {
+		if group.ID != 0 {
+			teacherGroupIDs = append(teacherGroupIDs, group.ID)
+		}
+	}
+
+	var mobileDeviceGroupIDs []int
+	for _, group := range resp.MobileDeviceGroupID {
+		if group.ID != 0 {
+			mobileDeviceGroupIDs = append(mobileDeviceGroupIDs, group.ID)
+		}
+	}
+
+	resourceData := map[string]interface{}{
+		"id":                      strconv.Itoa(resp.ID),
+		"name":                    resp.Name,
+		"description":             resp.Description,
+		"source":                  resp.Source,
+		"site_id":                 resp.Site.ID,
+		"students":                students,
+		"teachers":                teachers,
+		"teacher_ids":             teacherIDs,
+		"student_group_ids":       studentGroupIDs,
+		"teacher_group_ids":       teacherGroupIDs,
+		"mobile_device_group_ids": mobileDeviceGroupIDs,
+	}
+
+	meetingTime := resp.MeetingTimes.MeetingTime
+	if meetingTime.Days != "" {
+		resourceData["meeting_times"] = []interface{}{
+			map[string]interface{}{
+				"days":       meetingTime.Days,
+				"start_time": meetingTime.StartTime,
+				"end_time":   meetingTime.EndTime,
+			},
+		}
+	} else {
+		resourceData["meeting_times"] = nil
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,128 @@
+package clouddistributionpoint
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// uriCloudDistributionPoint is the cloud distribution point settings endpoint. The SDK only exposes its
+// upload capability sub-resource, so the settings are read into cloudDistributionPoint directly.
+const uriCloudDistributionPoint = "/api/v1/cloud-distribution-point"
+
+// cdnTypeJamfCloud is the CDN type reported when the Jamf Cloud Distribution Service (JCDS) is in use.
+const cdnTypeJamfCloud = "JAMF_CLOUD"
+
+// cloudDistributionPoint captures the cloud distribution point settings returned by Jamf Pro.
+type cloudDistributionPoint struct {
+	CdnType                string `json:"cdnType"`
+	Master                 bool   `json:"master"`
+	HasConnectionSucceeded bool   `json:"hasConnectionSucceeded"`
+	Message                string `json:"message"`
+	CdnUrl                 string `json:"cdnUrl"`
+}
+
+// DataSourceJamfProCloudDistributionPoint provides the cloud distribution point configuration of the Jamf Pro instance.
+func DataSourceJamfProCloudDistributionPoint() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A fixed identifier for the cloud distribution point configuration.",
+			},
+			"cdn_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content delivery network in use, e.g. 'JAMF_CLOUD', 'AMAZON_S3', 'AKAMAI', 'RACKSPACE' or 'NONE'.",
+			},
+			"jcds_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the Jamf Cloud Distribution Service (JCDS) is the configured cloud distribution point.",
+			},
+			"master": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cloud distribution point is the principal distribution point.",
+			},
+			"has_connection_succeeded": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Jamf Pro's last connection test to the cloud distribution point succeeded.",
+			},
+			"cdn_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the content delivery network, where applicable.",
+			},
+			"principal_distribution_technology": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cloud distribution point is the principal distribution technology for package uploads.",
+			},
+			"direct_upload_capable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether packages can be uploaded directly to the cloud distribution point through the Jamf Pro API.",
+			},
+		},
+	}
+}
+
+// dataSourceRead fetches the cloud distribution point settings and upload capability from Jamf Pro.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	var diags diag.Diagnostics
+
+	var settings cloudDistributionPoint
+	var capability *jamfpro.ResourceCloudDistributionPointUploadCapability
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		resp, apiErr := client.HTTP.DoRequest("GET", uriCloudDistributionPoint, nil, &settings)
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+		}
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+
+		capability, apiErr = client.GetCloudDistributionPointUploadCapability()
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Cloud Distribution Point after retries: %v", err))
+	}
+
+	d.SetId("jamfpro_cloud_distribution_point_singleton")
+
+	resourceData := map[string]interface{}{
+		"cdn_type":                          settings.CdnType,
+		"jcds_enabled":                      settings.CdnType == cdnTypeJamfCloud,
+		"master":                            settings.Master,
+		"has_connection_succeeded":          settings.HasConnectionSucceeded,
+		"cdn_url":                           settings.CdnUrl,
+		"principal_distribution_technology": capability.ID,
+		"direct_upload_capable":             capability.Name,
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,48 @@
+// common/backoff.go
+// This package contains the shared exponential backoff used by polling and retry loops.
+
+package common
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff computes exponentially increasing retry delays with random jitter, so that resources
+// polling Jamf Pro back off in the same way.
+type Backoff struct {
+	// Base is the delay before the first retry.
+	Base time.Duration
+	// Cap is the largest delay before jitter is applied.
+	Cap time.Duration
+	// Jitter is the fraction, between 0 and 1, by which each delay is randomly lengthened or shortened.
+	Jitter float64
+}
+
+// DefaultBackoff is the backoff used when a resource has no reason to choose its own.
+var DefaultBackoff = Backoff{
+	Base:   1 * time.Second,
+	Cap:    10 * time.Second,
+	Jitter: 0.2,
+}
+
+// Delay returns the delay before the given retry attempt, counting from zero. The delay doubles with each
+// attempt up to Cap, then is scaled by a random factor in [1-Jitter, 1+Jitter].
+func (b Backoff) Delay(attempt int) time.Duration {
+	delay := b.Cap
+	if attempt < 63 && b.Base > 0 {
+		if exp := b.Base << attempt; exp > 0 && exp < b.Cap {
+			delay = exp
+		}
+	}
+
+	jitter := b.Jitter
+	if jitter < 0 {
+		jitter = 0
+	} else if jitter > 1 {
+		jitter = 1
+	}
+
+	factor := 1 + jitter*(2*rand.Float64()-1)
+	return time.Duration(float64(delay) * factor)
+}
@@ -0,0 +1,61 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffDelayGrowth(t *testing.T) {
+	backoff := Backoff{Base: time.Second, Cap: 10 * time.Second}
+
+	want := []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for attempt, w := range want {
+		if got := backoff.Delay(attempt); got != w {
+			t.Errorf("Delay(%d) = %v, want %v", attempt, got, w)
+		}
+	}
+
+	// Attempts large enough to overflow the shift are held at the cap.
+	for _, attempt := range []int{40, 62, 63, 1000} {
+		if got := backoff.Delay(attempt); got != backoff.Cap {
+			t.Errorf("Delay(%d) = %v, want the cap %v", attempt, got, backoff.Cap)
+		}
+	}
+
+	if got := (Backoff{Cap: 5 * time.Second}).Delay(0); got != 5*time.Second {
+		t.Errorf("Delay with no base = %v, want the cap", got)
+	}
+}
+
+func TestBackoffDelayJitterBounds(t *testing.T) {
+	cases := []struct {
+		name   string
+		jitter float64
+		min    time.Duration
+		max    time.Duration
+	}{
+		{name: "no jitter", jitter: 0, min: 4 * time.Second, max: 4 * time.Second},
+		{name: "twenty percent", jitter: 0.2, min: 3200 * time.Millisecond, max: 4800 * time.Millisecond},
+		{name: "negative is treated as none", jitter: -0.5, min: 4 * time.Second, max: 4 * time.Second},
+		{name: "above one is clamped", jitter: 3, min: 0, max: 8 * time.Second},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			backoff := Backoff{Base: time.Second, Cap: 10 * time.Second, Jitter: tc.jitter}
+
+			seen := make(map[time.Duration]bool)
+			for i := 0; i < 1000; i++ {
+				got := backoff.Delay(2)
+				if got < tc.min || got > tc.max {
+					t.Fatalf("Delay(2) = %v, want between %v and %v", got, tc.min, tc.max)
+				}
+				seen[got] = true
+			}
+
+			if tc.min != tc.max && len(seen) < 2 {
+				t.Fatalf("Delay(2) returned the same value 1000 times, want jittered delays")
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// common/batchdelete.go
+// This package contains shared coalescing of concurrent deletes into bulk delete requests.
+
+package common
+
+import (
+	"sync"
+	"time"
+)
+
+// batchDeleteWindow is how long the first delete of a batch waits for Terraform to start deleting other objects of
+// the same kind before the batch is sent.
+const batchDeleteWindow = 250 * time.Millisecond
+
+// batchKey identifies the objects that can be deleted together: those of one kind on one client.
+type batchKey struct {
+	meta interface{}
+	kind string
+}
+
+// pendingBatch collects IDs until it is sent, then reports the outcome to every waiting caller. deleted is false
+// when the objects still need deleting one at a time.
+type pendingBatch struct {
+	ids     []string
+	done    chan struct{}
+	deleted bool
+}
+
+var (
+	batchesMu      sync.Mutex
+	pendingBatches = make(map[batchKey]*pendingBatch)
+)
+
+// BatchedDelete returns a delete function for use with Delete that coalesces deletes of the same kind issued during
+// a terraform destroy into a single call to deleteMultiple. If the bulk request fails, for example because one of
+// the objects no longer exists, each object is deleted on its own with deleteOne so that the existing per-ID error
+// handling and retries still apply.
+func BatchedDelete(meta interface{}, kind string, deleteMultiple func([]string) error, deleteOne func(string) error) func(string) error {
+	return func(resourceID string) error {
+		key := batchKey{meta: meta, kind: kind}
+
+		batchesMu.Lock()
+		batch, ok := pendingBatches[key]
+		if !ok {
+			batch = &pendingBatch{done: make(chan struct{})}
+			pendingBatches[key] = batch
+			time.AfterFunc(batchDeleteWindow, func() {
+				batchesMu.Lock()
+				delete(pendingBatches, key)
+				ids := batch.ids
+				batchesMu.Unlock()
+
+				// A lone delete, such as a replacement, gains nothing from the bulk endpoint.
+				batch.deleted = len(ids) > 1 && deleteMultiple(ids) == nil
+				close(batch.done)
+			})
+		}
+		batch.ids = append(batch.ids, resourceID)
+		batchesMu.Unlock()
+
+		<-batch.done
+		if !batch.deleted {
+			return deleteOne(resourceID)
+		}
+		return nil
+	}
+}
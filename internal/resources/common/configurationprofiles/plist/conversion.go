@@ -4,12 +4,14 @@
 package plist
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"html"
 	"log"
 	"strconv"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"howett.net/plist"
@@ -17,7 +19,7 @@ import (
 
 // ConvertHCLToPlist builds a plist from the Terraform HCL schema data
 // Used by plist generator resource to convert HCL data to plist
-func ConvertHCLToPlist(d *schema.ResourceData) (string, error) {
+func ConvertHCLToPlist(ctx context.Context, d *schema.ResourceData) (string, error) {
 	profile := mapSchemaToProfile(d)
 	plistData, err := MarshalPayload(profile)
 	if err != nil {
@@ -30,7 +32,7 @@ func ConvertHCLToPlist(d *schema.ResourceData) (string, error) {
 	}
 	unescapedPrettyPlistXML := html.UnescapeString(string(prettyPlistXML))
 
-	log.Printf("[DEBUG] Constructed Plist XML from HCL serialization:\n%s\n", unescapedPrettyPlistXML)
+	common.LogConstructedPayload(ctx, "Plist XML from HCL serialization", unescapedPrettyPlistXML)
 
 	return string(plistData), nil
 }
@@ -162,7 +164,7 @@ func ConvertPlistToHCL(plistXML string) ([]interface{}, error) {
 		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	log.Printf("[DEBUG] Constructed TF state structure from plist:\n%s\n", string(jsonData))
+	log.Printf("[DEBUG] Constructed TF state structure from plist:\n%s\n", common.RedactSensitiveFields(string(jsonData)))
 
 	return payloadsList, nil
 }
@@ -3,15 +3,18 @@ package common
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // Func Definitions
-type payoadConstructorFunc[T any] func(*schema.ResourceData) (*T, error)
+type payoadConstructorFunc[T any] func(context.Context, *schema.ResourceData) (*T, error)
 
 type sdkCreateUpdateFunc[PayloadType any, ResponseType any] func(Payload *PayloadType) (*ResponseType, error)
 
@@ -37,33 +40,40 @@ func Create[sdkPayloadType any, sdkResponseType any](
 
 	var diags diag.Diagnostics
 
-	payload, err := construct(d)
-	payloadtypeName := reflect.TypeOf(payload).Name()
+	payload, err := construct(ctx, d)
+	payloadtypeName := typeName[sdkPayloadType]()
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct %s: %v", payloadtypeName, err))
 	}
 
+	logCtx, requestID := newOperationContext(ctx, "create", payloadtypeName, "")
+	logBody(logCtx, "request_body", payload)
+
 	var outcomeResponse *sdkResponseType
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var apiErr error
 		outcomeResponse, apiErr = serverOutcomeFunc(payload)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			logAPIError(logCtx, apiErr)
+			return client.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	if err != nil {
-		return append(diags, diag.FromErr(fmt.Errorf("failed to create %s after retries: %v", payloadtypeName, err))...)
+		return append(diags, diag.FromErr(fmt.Errorf("failed to create %s after retries (request ID: %s): %v", payloadtypeName, requestID, err))...)
 	}
 
+	logBody(logCtx, "response_body", outcomeResponse)
+
 	idField, err := getIDField(outcomeResponse)
 	if err != nil {
 		return append(diags, diag.FromErr(fmt.Errorf("error getting ID field from response: %v", err))...)
 	}
 
 	d.SetId(idField.(string))
+	tflog.Debug(tflog.SetField(logCtx, "jamf_id", d.Id()), "Created Jamf Pro object")
 
 	return append(diags, reader(ctx, d, meta)...)
 }
@@ -76,33 +86,92 @@ func Update[sdkPayloadType any, sdkResponseType any](
 	outcomeFunc sdkUpdateFunc[sdkPayloadType, sdkResponseType],
 	reader providerReadFunc,
 
+) diag.Diagnostics {
+	return update(ctx, d, meta, constructor, outcomeFunc, reader, nil)
+}
+
+// update sends the constructed payload for an existing object. When currentVersionLock is set, a 409 Conflict
+// is treated as a stale optimistic lock: the latest lock is fetched into version_lock, the payload is rebuilt
+// and the update is sent once more.
+func update[sdkPayloadType any, sdkResponseType any](
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+	constructor payoadConstructorFunc[sdkPayloadType],
+	outcomeFunc sdkUpdateFunc[sdkPayloadType, sdkResponseType],
+	reader providerReadFunc,
+	currentVersionLock versionLockFunc,
 ) diag.Diagnostics {
 
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	payload, err := constructor(d)
-	payloadtypeName := reflect.TypeOf(payload).Name()
+	payload, err := constructor(ctx, d)
+	payloadtypeName := typeName[sdkPayloadType]()
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro %s for update: %v", payloadtypeName, err))
 	}
 
+	logCtx, requestID := newOperationContext(ctx, "update", payloadtypeName, resourceID)
+	logBody(logCtx, "request_body", payload)
+
+	lockRefreshed := false
+	var outcomeResponse *sdkResponseType
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		_, apiErr := outcomeFunc(resourceID, payload)
+		var apiErr error
+		outcomeResponse, apiErr = outcomeFunc(resourceID, payload)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			logAPIError(logCtx, apiErr)
+			if currentVersionLock != nil && !lockRefreshed && client.StatusCode(apiErr) == http.StatusConflict {
+				lockRefreshed = true
+				if refreshErr := refreshVersionLock(logCtx, d, currentVersionLock); refreshErr != nil {
+					return retry.NonRetryableError(fmt.Errorf("%v; refreshing the version lock also failed: %v", apiErr, refreshErr))
+				}
+				if payload, err = constructor(ctx, d); err != nil {
+					return retry.NonRetryableError(fmt.Errorf("failed to reconstruct payload with the refreshed version lock: %v", err))
+				}
+				return retry.RetryableError(apiErr)
+			}
+			return client.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf pro %s (ID: %s) after retries: %v", payloadtypeName, resourceID, err))
+		return diag.FromErr(fmt.Errorf("failed to update Jamf pro %s (ID: %s) after retries (request ID: %s): %v", payloadtypeName, resourceID, requestID, err))
+	}
+
+	if err := checkUpdatedID(resourceID, outcomeResponse); err != nil {
+		return diag.FromErr(fmt.Errorf("refusing to adopt the result of updating Jamf Pro %s (ID: %s) (request ID: %s): %v", payloadtypeName, resourceID, requestID, err))
 	}
 
+	tflog.Debug(logCtx, "Updated Jamf Pro object")
+
 	return append(diags, reader(ctx, d, meta)...)
 }
 
+// checkUpdatedID returns an error if the update response identifies a different object than the one in
+// state, so that a resource is never silently re-pointed at another object. Responses without an ID are
+// accepted.
+func checkUpdatedID(resourceID string, response interface{}) error {
+	v := reflect.ValueOf(response)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	updatedID, err := getIDField(response)
+	if err != nil {
+		return nil
+	}
+
+	if id, ok := updatedID.(string); ok && id != "" && id != "0" && id != resourceID {
+		return fmt.Errorf("Jamf Pro returned object ID %s, which does not match the ID in state", id)
+	}
+
+	return nil
+}
+
 // Read
 func Read[sdkResponseType any](
 	ctx context.Context,
@@ -116,20 +185,30 @@ func Read[sdkResponseType any](
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
+	logCtx, _ := newOperationContext(ctx, "read", typeName[sdkResponseType](), resourceID)
+
 	var response *sdkResponseType
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
 		response, apiErr = serverOutcomeFunc(resourceID)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			logAPIError(logCtx, apiErr)
+			// Objects that were just created or updated can briefly return 404 while the change propagates.
+			if !removeDeleteResourcesFromState && client.StatusCode(apiErr) == http.StatusNotFound {
+				return retry.RetryableError(apiErr)
+			}
+			return client.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	if err != nil {
+		tflog.Debug(logCtx, "Read failed after retries")
 		return append(diags, HandleResourceNotFoundError(err, d, removeDeleteResourcesFromState)...)
 	}
 
+	logBody(logCtx, "response_body", response)
+
 	return append(diags, providerStateFunc(d, response)...)
 }
 
@@ -137,19 +216,26 @@ func Read[sdkResponseType any](
 func Delete(ctx context.Context, d *schema.ResourceData, meta interface{}, serverOutcomeFunc sdkDeleteFunc) diag.Diagnostics {
 	var diags diag.Diagnostics
 	resourceID := d.Id()
+	resourceName := d.Get("name").(string)
+
+	logCtx, requestID := newOperationContext(ctx, "delete", "", resourceID)
+	logCtx = tflog.SetField(logCtx, "name", resourceName)
 
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		apiErr := serverOutcomeFunc(resourceID)
 		if apiErr != nil {
-			return retry.RetryableError(apiErr)
+			logAPIError(logCtx, apiErr)
+			return client.RetryError(apiErr)
 		}
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro resource '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
+		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro resource '%s' (ID: %s) after retries (request ID: %s): %v", resourceName, resourceID, requestID, err))
 	}
 
+	tflog.Debug(logCtx, "Deleted Jamf Pro object")
+
 	d.SetId("")
 
 	return diags
@@ -0,0 +1,363 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	buildingsPath = "/api/v1/buildings"
+	buildingPath  = "/api/v1/buildings/1"
+	buildingBody  = `{"id":"1","name":"Main Office"}`
+)
+
+var buildingSchema = map[string]*schema.Schema{
+	"name": {Type: schema.TypeString, Optional: true},
+}
+
+// newBuildingData returns resource data for a building, in state under id unless id is empty.
+func newBuildingData(t *testing.T, id string) *schema.ResourceData {
+	t.Helper()
+
+	d := schema.TestResourceDataRaw(t, buildingSchema, map[string]interface{}{"name": "Main Office"})
+	d.SetId(id)
+	return d
+}
+
+// newMockClient starts a mock Jamf Pro server and returns it with a client authenticated against it.
+func newMockClient(t *testing.T) (*jamfmock.Server, *jamfpro.Client) {
+	t.Helper()
+
+	server := jamfmock.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := server.Client()
+	if err != nil {
+		t.Fatalf("building mock client: %v", err)
+	}
+	return server, client
+}
+
+func buildingState(d *schema.ResourceData, building *jamfpro.ResourceBuilding) diag.Diagnostics {
+	if err := d.Set("name", building.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func readBuilding(client *jamfpro.Client, cleanup bool) providerReadFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return Read(ctx, d, meta, cleanup, client.GetBuildingByID, buildingState)
+	}
+}
+
+func constructBuilding(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceBuilding, error) {
+	return &jamfpro.ResourceBuilding{Name: d.Get("name").(string)}, nil
+}
+
+func TestCreate(t *testing.T) {
+	cases := []struct {
+		name      string
+		responses []jamfmock.Response
+		wantID    string
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "created",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusCreated, `{"id":"1"}`)},
+			wantID:    "1",
+			wantCalls: 1,
+		},
+		{
+			name:      "retryable server error",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusServiceUnavailable, `{}`), jamfmock.JSON(http.StatusCreated, `{"id":"1"}`)},
+			wantID:    "1",
+			wantCalls: 2,
+		},
+		{
+			name:      "rate limited",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusTooManyRequests, `{}`), jamfmock.JSON(http.StatusCreated, `{"id":"1"}`)},
+			wantID:    "1",
+			wantCalls: 2,
+		},
+		{
+			name:      "permanent client error",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusBadRequest, `{"httpStatus":400,"errors":[{"code":"INVALID_FIELD"}]}`)},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, client := newMockClient(t)
+			server.Handle(http.MethodPost, buildingsPath, tc.responses...)
+			server.Handle(http.MethodGet, buildingPath, jamfmock.JSON(http.StatusOK, buildingBody))
+
+			d := newBuildingData(t, "")
+			diags := Create(context.Background(), d, nil, constructBuilding, client.CreateBuilding, readBuilding(client, false))
+
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", diags, tc.wantErr)
+			}
+			if d.Id() != tc.wantID {
+				t.Fatalf("got ID %q, want %q", d.Id(), tc.wantID)
+			}
+			if got := len(server.Requests(http.MethodPost, buildingsPath)); got != tc.wantCalls {
+				t.Fatalf("got %d create requests, want %d", got, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	cases := []struct {
+		name        string
+		cleanup     bool
+		responses   []jamfmock.Response
+		timeout     time.Duration
+		wantID      string
+		wantName    string
+		wantCalls   int
+		wantErr     bool
+		wantWarning bool
+	}{
+		{
+			name:      "found",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusOK, buildingBody)},
+			wantID:    "1",
+			wantName:  "Main Office",
+			wantCalls: 1,
+		},
+		{
+			name:      "retryable server error",
+			cleanup:   true,
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusBadGateway, `{}`), jamfmock.JSON(http.StatusOK, buildingBody)},
+			wantID:    "1",
+			wantName:  "Main Office",
+			wantCalls: 2,
+		},
+		{
+			name:      "permanent unauthorized",
+			cleanup:   true,
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusUnauthorized, `{}`)},
+			wantID:    "1",
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:      "permanent forbidden",
+			cleanup:   true,
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusForbidden, `{}`)},
+			wantID:    "1",
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:        "not found with cleanup",
+			cleanup:     true,
+			responses:   []jamfmock.Response{jamfmock.NotFound()},
+			wantCalls:   1,
+			wantWarning: true,
+		},
+		{
+			name:      "not found without cleanup is retried",
+			responses: []jamfmock.Response{jamfmock.NotFound(), jamfmock.JSON(http.StatusOK, buildingBody)},
+			wantID:    "1",
+			wantName:  "Main Office",
+			wantCalls: 2,
+		},
+		{
+			name:      "not found without cleanup keeps the ID",
+			responses: []jamfmock.Response{jamfmock.NotFound()},
+			timeout:   time.Second,
+			wantID:    "1",
+			wantErr:   true,
+		},
+		{
+			name:      "context timeout",
+			cleanup:   true,
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusServiceUnavailable, `{}`)},
+			timeout:   time.Second,
+			wantID:    "1",
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, client := newMockClient(t)
+			server.Handle(http.MethodGet, buildingPath, tc.responses...)
+
+			ctx := context.Background()
+			if tc.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+				defer cancel()
+			}
+
+			d := newBuildingData(t, "1")
+			if err := d.Set("name", ""); err != nil {
+				t.Fatal(err)
+			}
+
+			start := time.Now()
+			diags := readBuilding(client, tc.cleanup)(ctx, d, nil)
+
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", diags, tc.wantErr)
+			}
+			if gotWarning := len(diags) > 0 && diags[0].Severity == diag.Warning; gotWarning != tc.wantWarning {
+				t.Fatalf("got diagnostics %v, want warning %t", diags, tc.wantWarning)
+			}
+			if d.Id() != tc.wantID {
+				t.Fatalf("got ID %q, want %q", d.Id(), tc.wantID)
+			}
+			if got := d.Get("name").(string); got != tc.wantName {
+				t.Fatalf("got name %q, want %q", got, tc.wantName)
+			}
+
+			requests := len(server.Requests(http.MethodGet, buildingPath))
+			if tc.timeout > 0 {
+				if requests < 2 {
+					t.Fatalf("got %d read requests before the context expired, want retries", requests)
+				}
+				if elapsed := time.Since(start); elapsed > 5*tc.timeout {
+					t.Fatalf("read took %v, want it to stop soon after the %v context timeout", elapsed, tc.timeout)
+				}
+			} else if requests != tc.wantCalls {
+				t.Fatalf("got %d read requests, want %d", requests, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := []struct {
+		name      string
+		responses []jamfmock.Response
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "updated",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusOK, buildingBody)},
+			wantCalls: 1,
+		},
+		{
+			name:      "retryable server error",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusInternalServerError, `{}`), jamfmock.JSON(http.StatusOK, buildingBody)},
+			wantCalls: 2,
+		},
+		{
+			name:      "conflict without a version lock is permanent",
+			responses: []jamfmock.Response{jamfmock.VersionLockConflict()},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:      "response for another object",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusOK, `{"id":"2","name":"Main Office"}`)},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, client := newMockClient(t)
+			server.Handle(http.MethodPut, buildingPath, tc.responses...)
+			server.Handle(http.MethodGet, buildingPath, jamfmock.JSON(http.StatusOK, buildingBody))
+
+			d := newBuildingData(t, "1")
+			diags := Update(context.Background(), d, nil, constructBuilding, client.UpdateBuildingByID, readBuilding(client, true))
+
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", diags, tc.wantErr)
+			}
+			if d.Id() != "1" {
+				t.Fatalf("got ID %q, want the ID in state to be kept", d.Id())
+			}
+			if got := len(server.Requests(http.MethodPut, buildingPath)); got != tc.wantCalls {
+				t.Fatalf("got %d update requests, want %d", got, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := []struct {
+		name      string
+		responses []jamfmock.Response
+		timeout   time.Duration
+		wantID    string
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "deleted",
+			responses: []jamfmock.Response{{StatusCode: http.StatusNoContent}},
+			wantCalls: 1,
+		},
+		{
+			name:      "retryable server error",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusServiceUnavailable, `{}`), {StatusCode: http.StatusNoContent}},
+			wantCalls: 2,
+		},
+		{
+			name:      "permanent forbidden",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusForbidden, `{}`)},
+			wantID:    "1",
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:      "context timeout",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusInternalServerError, `{}`)},
+			timeout:   time.Second,
+			wantID:    "1",
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, client := newMockClient(t)
+			server.Handle(http.MethodDelete, buildingPath, tc.responses...)
+
+			ctx := context.Background()
+			if tc.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+				defer cancel()
+			}
+
+			d := newBuildingData(t, "1")
+			diags := Delete(ctx, d, nil, client.DeleteBuildingByID)
+
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", diags, tc.wantErr)
+			}
+			if d.Id() != tc.wantID {
+				t.Fatalf("got ID %q, want %q", d.Id(), tc.wantID)
+			}
+
+			requests := len(server.Requests(http.MethodDelete, buildingPath))
+			if tc.timeout > 0 {
+				if requests < 2 {
+					t.Fatalf("got %d delete requests before the context expired, want retries", requests)
+				}
+			} else if requests != tc.wantCalls {
+				t.Fatalf("got %d delete requests, want %d", requests, tc.wantCalls)
+			}
+		})
+	}
+}
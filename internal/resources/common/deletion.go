@@ -0,0 +1,32 @@
+// common/deletion.go
+// This package contains the shared guard for resources that expose allow_deletion.
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// CheckDeletionAllowed returns an error diagnostic if allow_deletion is false in state. Objects imported
+// before allow_deletion was set have no value in state and may be deleted.
+func CheckDeletionAllowed(d *schema.ResourceData) diag.Diagnostics {
+	rawState := d.GetRawState()
+	if rawState.IsNull() {
+		return nil
+	}
+
+	allowDeletion := rawState.GetAttr("allow_deletion")
+	if allowDeletion.IsNull() || !allowDeletion.IsKnown() || allowDeletion.True() {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Deletion is not allowed",
+		Detail: fmt.Sprintf("'%s' (ID: %s) has allow_deletion set to false. Set allow_deletion = true and apply before destroying or replacing it.",
+			d.Get("name").(string), d.Id()),
+	}}
+}
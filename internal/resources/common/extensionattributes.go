@@ -0,0 +1,34 @@
+// common/extensionattributes.go
+// This package contains validation shared by the computer and mobile device extension attribute resources.
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// extensionAttributeDateLayouts are the date formats Jamf Pro accepts for Date extension attribute values.
+var extensionAttributeDateLayouts = []string{"2006-01-02", "2006-01-02 15:04:05"}
+
+// ValidateExtensionAttributeChoice returns an error if a pop-up menu choice cannot be stored as the given data type.
+// The data type is matched case-insensitively, as the Jamf Pro API uses INTEGER and the classic API uses Integer.
+func ValidateExtensionAttributeChoice(dataType string, choice string) error {
+	switch strings.ToUpper(dataType) {
+	case "INTEGER":
+		if _, err := strconv.Atoi(choice); err != nil {
+			return fmt.Errorf("'%s' is not a valid integer, which is required when data_type is '%s'", choice, dataType)
+		}
+	case "DATE":
+		for _, layout := range extensionAttributeDateLayouts {
+			if _, err := time.Parse(layout, choice); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("'%s' is not a valid date, which is required when data_type is '%s'; use YYYY-MM-DD or YYYY-MM-DD hh:mm:ss", choice, dataType)
+	}
+
+	return nil
+}
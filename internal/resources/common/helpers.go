@@ -7,11 +7,18 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"html"
 	"log"
 	"reflect"
 	"strconv"
 )
 
+// DecodeName returns an object name read from Jamf Pro with any HTML entities, such as &amp; or &#39;, decoded,
+// so that it matches the plain text name in the configuration.
+func DecodeName(name string) string {
+	return html.UnescapeString(name)
+}
+
 // HashString calculates the SHA-256 hash of a string and returns it as a hexadecimal string.
 func HashString(s string) string {
 	h := sha256.New()
@@ -21,7 +28,8 @@ func HashString(s string) string {
 	return hash
 }
 
-// SerializeAndRedactXML serializes a resource to XML and redacts specified fields.
+// SerializeAndRedactXML serializes a resource to XML and redacts specified fields, along with any nested
+// password, secret, passphrase or token element.
 func SerializeAndRedactXML(resource interface{}, redactFields []string) (string, error) {
 	v := reflect.ValueOf(resource)
 	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
@@ -42,11 +50,12 @@ func SerializeAndRedactXML(resource interface{}, redactFields []string) (string,
 	if marshaledXML, err := xml.MarshalIndent(resourceCopy.Interface(), "", "  "); err != nil {
 		return "", fmt.Errorf("failed to marshal %s to XML: %v", v.Elem().Type(), err)
 	} else {
-		return string(marshaledXML), nil
+		return RedactSensitiveFields(string(marshaledXML)), nil
 	}
 }
 
-// SerializeAndRedactJSON serializes a resource to JSON and redacts specified fields.
+// SerializeAndRedactJSON serializes a resource to JSON and redacts specified fields, along with any nested
+// password, secret, passphrase or token key.
 func SerializeAndRedactJSON(resource interface{}, redactFields []string) (string, error) {
 	v := reflect.ValueOf(resource)
 	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
@@ -69,7 +78,7 @@ func SerializeAndRedactJSON(resource interface{}, redactFields []string) (string
 		return "", fmt.Errorf("failed to marshal %s to JSON: %v", v.Elem().Type(), err)
 	}
 
-	return string(marshaledJSON), nil
+	return RedactSensitiveFields(string(marshaledJSON)), nil
 }
 
 func getIDField(response interface{}) (any, error) {
@@ -0,0 +1,42 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfmock"
+)
+
+func TestDecodeName(t *testing.T) {
+	cases := []struct {
+		name    string
+		encoded string
+		want    string
+	}{
+		{name: "ampersand", encoded: "Sales &amp; Marketing", want: "Sales & Marketing"},
+		{name: "numeric apostrophe", encoded: "Finance&#39;s Macs", want: "Finance's Macs"},
+		{name: "named apostrophe", encoded: "Finance&apos;s Macs", want: "Finance's Macs"},
+		{name: "quotes and angle brackets", encoded: "&quot;Tier&quot; &lt;1&gt;", want: `"Tier" <1>`},
+		{name: "plain name", encoded: "Engineering", want: "Engineering"},
+		{name: "bare ampersand", encoded: "R&D", want: "R&D"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := DecodeName(tc.encoded); got != tc.want {
+				t.Fatalf("DecodeName(%q) = %q, want %q", tc.encoded, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDecodeNameRoundTrip(t *testing.T) {
+	for _, name := range []string{"Sales & Marketing", "Finance's Macs", `"Tier" <1>`, "A & B's <C>", "Engineering"} {
+		encoded := jamfmock.HTMLEncodedName(name)
+		if got := DecodeName(encoded); got != name {
+			t.Errorf("DecodeName(%q) = %q, want the original %q", encoded, got, name)
+		}
+		if got := DecodeName(name); got != name {
+			t.Errorf("DecodeName(%q) changed a name that was not encoded to %q", name, got)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package jamfmock
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+)
+
+// JSON returns a response with the given status code and JSON body.
+func JSON(statusCode int, body string) Response {
+	return Response{StatusCode: statusCode, ContentType: "application/json", Body: body}
+}
+
+// XML returns a response with the given status code and XML body, as returned by the Classic API.
+func XML(statusCode int, body string) Response {
+	return Response{StatusCode: statusCode, ContentType: "application/xml", Body: body}
+}
+
+// NotFound returns the 404 Jamf Pro sends for an object that does not exist.
+func NotFound() Response {
+	return JSON(http.StatusNotFound, `{"httpStatus":404,"errors":[{"code":"INVALID_ID","description":"Object not found"}]}`)
+}
+
+// VersionLockConflict returns the 409 Jamf Pro sends when an update carries a stale versionLock.
+func VersionLockConflict() Response {
+	return JSON(http.StatusConflict, `{"httpStatus":409,"errors":[{"code":"OPTIMISTIC_LOCK_FAILED","description":"Optimistic lock failed"}]}`)
+}
+
+// HTMLEncodedName returns name with its special characters HTML-encoded, as Jamf Pro returns some names.
+func HTMLEncodedName(name string) string {
+	return html.EscapeString(name)
+}
+
+// ClassicObject returns a minimal Classic API body for an object with the given root element, ID and name.
+// The name is written as given, so pass it through HTMLEncodedName to reproduce Jamf Pro's encoding.
+func ClassicObject(root string, id int, name string) string {
+	return fmt.Sprintf("<%s><general><id>%d</id><name>%s</name></general></%s>", root, id, name, root)
+}
+
+// ReorderedCriteria returns the criteria of a smart group or advanced search as Classic API XML. Each criterion
+// keeps the priority of its position in names, but they are listed in reverse, reproducing Jamf Pro returning
+// criteria out of priority order.
+func ReorderedCriteria(names ...string) string {
+	criteria := fmt.Sprintf("<criteria><size>%d</size>", len(names))
+	for i := len(names) - 1; i >= 0; i-- {
+		criteria += fmt.Sprintf("<criterion><name>%s</name><priority>%d</priority><and_or>and</and_or><search_type>is</search_type><value></value></criterion>", names[i], i)
+	}
+	return criteria + "</criteria>"
+}
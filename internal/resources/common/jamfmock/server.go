@@ -0,0 +1,141 @@
+// common/jamfmock/server.go
+// This package contains an httptest-based mock Jamf Pro server for exercising CRUD paths without a real tenant.
+
+package jamfmock
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/deploymenttheory/go-api-http-client-integrations/jamf/jamfprointegration"
+	"github.com/deploymenttheory/go-api-http-client/httpclient"
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"go.uber.org/zap"
+)
+
+// Response is a canned reply returned by the mock server.
+type Response struct {
+	StatusCode  int
+	ContentType string
+	Body        string
+}
+
+// RecordedRequest is a request received by the mock server, kept so that payloads can be inspected.
+type RecordedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   string
+}
+
+// Server is a mock Jamf Pro server. Routes are registered with Handle; each request to a route is
+// answered with the next queued response, and the last response is repeated once the queue is drained.
+// Authentication and version endpoints are answered automatically.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	routes   map[string][]Response
+	requests []RecordedRequest
+}
+
+// NewServer starts a mock Jamf Pro server. Call Close when finished with it.
+func NewServer() *Server {
+	s := &Server{routes: make(map[string][]Response)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	token := JSON(http.StatusOK, fmt.Sprintf(`{"access_token":"mock-token","token_type":"Bearer","expires_in":3600,"token":"mock-token","expires":"%s"}`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339)))
+	s.Handle(http.MethodPost, "/api/oauth/token", token)
+	s.Handle(http.MethodPost, "/api/v1/auth/token", token)
+	s.Handle(http.MethodPost, "/api/v1/auth/keep-alive", token)
+	s.Handle(http.MethodPost, "/api/v1/auth/invalidate-token", Response{StatusCode: http.StatusNoContent})
+	s.Handle(http.MethodGet, "/api/v1/jamf-pro-version", JSON(http.StatusOK, `{"version":"mock"}`))
+
+	return s
+}
+
+// Handle queues responses for requests with the given method and path, replacing any already queued.
+func (s *Server) Handle(method, path string, responses ...Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.routes[method+" "+path] = responses
+}
+
+// Requests returns every request received for the given method and path, in the order they arrived.
+func (s *Server) Requests(method, path string) []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var matched []RecordedRequest
+	for _, request := range s.requests {
+		if request.Method == method && request.Path == path {
+			matched = append(matched, request)
+		}
+	}
+	return matched
+}
+
+// Client returns a Jamf Pro SDK client authenticated against the mock server with OAuth2.
+func (s *Server) Client() (*jamfpro.Client, error) {
+	logger := zap.NewNop().Sugar()
+	executor := &httpclient.ProdExecutor{Client: s.Server.Client()}
+
+	integration, err := jamfprointegration.BuildWithOAuth(s.URL, logger, time.Minute, "mock-client-id", "mock-client-secret", true, executor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate with the mock Jamf Pro server: %v", err)
+	}
+
+	config := httpclient.ClientConfig{
+		Integration:              integration,
+		Sugar:                    logger,
+		HideSensitiveData:        true,
+		TokenRefreshBufferPeriod: time.Minute,
+		HTTPExecutor:             &httpclient.ProdExecutor{Client: s.Server.Client()},
+	}
+
+	httpClient, err := config.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build client for the mock Jamf Pro server: %v", err)
+	}
+
+	return &jamfpro.Client{HTTP: httpClient}, nil
+}
+
+// serveHTTP records the request and writes the next queued response for its route, or 404 if none is registered.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, RecordedRequest{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Body:   string(body),
+	})
+
+	key := r.Method + " " + r.URL.Path
+	queued, ok := s.routes[key]
+	var response Response
+	if ok && len(queued) > 0 {
+		response = queued[0]
+		if len(queued) > 1 {
+			s.routes[key] = queued[1:]
+		}
+	}
+	s.mu.Unlock()
+
+	if !ok || len(queued) == 0 {
+		response = NotFound()
+	}
+
+	if response.ContentType != "" {
+		w.Header().Set("Content-Type", response.ContentType)
+	}
+	w.WriteHeader(response.StatusCode)
+	_, _ = io.WriteString(w, response.Body)
+}
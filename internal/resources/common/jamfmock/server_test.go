@@ -0,0 +1,93 @@
+package jamfmock
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+func TestServerReplaysQueuedResponses(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Handle(http.MethodGet, "/api/v1/buildings/1",
+		NotFound(),
+		JSON(http.StatusOK, `{"id":"1","name":"Main Office"}`),
+	)
+
+	client, err := server.Client()
+	if err != nil {
+		t.Fatalf("building client: %v", err)
+	}
+
+	if _, err := client.GetBuildingByID("1"); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Fatalf("first request: got error %v, want a 404", err)
+	}
+
+	// The last queued response is repeated once the queue is drained.
+	for i := 0; i < 2; i++ {
+		building, err := client.GetBuildingByID("1")
+		if err != nil {
+			t.Fatalf("request %d: %v", i+2, err)
+		}
+		if building.Name != "Main Office" {
+			t.Fatalf("request %d: got name %q, want %q", i+2, building.Name, "Main Office")
+		}
+	}
+
+	if got := len(server.Requests(http.MethodGet, "/api/v1/buildings/1")); got != 3 {
+		t.Fatalf("got %d recorded requests, want 3", got)
+	}
+}
+
+func TestServerRecordsRequestBodies(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	server.Handle(http.MethodPost, "/api/v1/buildings", JSON(http.StatusCreated, `{"id":"7","href":"/api/v1/buildings/7"}`))
+
+	client, err := server.Client()
+	if err != nil {
+		t.Fatalf("building client: %v", err)
+	}
+
+	created, err := client.CreateBuilding(&jamfpro.ResourceBuilding{Name: "Annex"})
+	if err != nil {
+		t.Fatalf("creating building: %v", err)
+	}
+	if created.ID != "7" {
+		t.Fatalf("got ID %q, want %q", created.ID, "7")
+	}
+
+	requests := server.Requests(http.MethodPost, "/api/v1/buildings")
+	if len(requests) != 1 || !strings.Contains(requests[0].Body, `"name":"Annex"`) {
+		t.Fatalf("got recorded requests %+v, want one carrying the building name", requests)
+	}
+
+	if _, err := client.GetBuildingByID("2"); err == nil {
+		t.Fatal("request to an unregistered route succeeded, want a 404")
+	}
+}
+
+func TestClassicResponseHelpers(t *testing.T) {
+	name := HTMLEncodedName("Sales & Marketing")
+	if name != "Sales &amp; Marketing" {
+		t.Fatalf("HTMLEncodedName: got %q", name)
+	}
+
+	object := ClassicObject("computer_group", 3, name)
+	if want := "<computer_group><general><id>3</id><name>Sales &amp; Marketing</name></general></computer_group>"; object != want {
+		t.Fatalf("ClassicObject: got %q, want %q", object, want)
+	}
+
+	criteria := ReorderedCriteria("First", "Second")
+	want := "<criteria><size>2</size>" +
+		"<criterion><name>Second</name><priority>1</priority><and_or>and</and_or><search_type>is</search_type><value></value></criterion>" +
+		"<criterion><name>First</name><priority>0</priority><and_or>and</and_or><search_type>is</search_type><value></value></criterion>" +
+		"</criteria>"
+	if criteria != want {
+		t.Fatalf("ReorderedCriteria: got %s, want %s", criteria, want)
+	}
+}
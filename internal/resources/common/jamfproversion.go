@@ -0,0 +1,100 @@
+// common/jamfproversion.go
+// This package contains the Jamf Pro server version recorded when the provider is configured, for version gating.
+
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// jamfProVersions records the parsed Jamf Pro version reported to each client.
+var jamfProVersions sync.Map
+
+// SetJamfProVersion records the Jamf Pro version the given client is connected to.
+func SetJamfProVersion(client *jamfpro.Client, version []int) {
+	jamfProVersions.Store(client, version)
+}
+
+// JamfProVersion returns the Jamf Pro version recorded for the client in meta, if it is known.
+func JamfProVersion(meta interface{}) ([]int, bool) {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return nil, false
+	}
+
+	version, ok := jamfProVersions.Load(client)
+	if !ok {
+		return nil, false
+	}
+	return version.([]int), true
+}
+
+// CheckJamfProVersion returns an error naming the feature if the Jamf Pro server is older than minimum,
+// such as "11.10.0". It returns nil when the server version is not known.
+func CheckJamfProVersion(meta interface{}, feature string, minimum string) error {
+	version, ok := JamfProVersion(meta)
+	if !ok {
+		return nil
+	}
+
+	required, ok := ParseJamfProVersion(minimum)
+	if !ok {
+		return fmt.Errorf("invalid minimum Jamf Pro version '%s' for %s", minimum, feature)
+	}
+
+	if CompareVersions(version, required) < 0 {
+		return fmt.Errorf("%s requires Jamf Pro %s or later, but the server runs %s", feature, FormatVersion(required), FormatVersion(version))
+	}
+
+	return nil
+}
+
+// ParseJamfProVersion parses the numeric part of a version such as "11.9.1-t1726569712".
+func ParseJamfProVersion(raw string) ([]int, bool) {
+	numeric := strings.SplitN(raw, "-", 2)[0]
+
+	var version []int
+	for _, part := range strings.Split(numeric, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, false
+		}
+		version = append(version, n)
+	}
+
+	return version, true
+}
+
+// CompareVersions returns -1, 0 or 1 as a is older than, the same as or newer than b. Missing parts count as zero.
+func CompareVersions(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// FormatVersion joins version parts with dots.
+func FormatVersion(version []int) string {
+	parts := make([]string, len(version))
+	for i, n := range version {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ".")
+}
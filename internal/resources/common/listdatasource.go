@@ -0,0 +1,101 @@
+// common/listdatasource.go
+// This package contains a shared data source that lists every object of a type, to support bulk imports.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ListDataSource returns a data source exposing the ID and name of every object of the given kind,
+// as returned by list and optionally filtered by name_regex. The output can be used to script
+// terraform import across a whole tenant or to map names to IDs with for_each.
+func ListDataSource(kind string, list func(*jamfpro.Client) ([]NamedObject, error)) *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return readList(ctx, d, meta, kind, list)
+		},
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  fmt.Sprintf("A regular expression used to filter each %s by name. All are returned when omitted.", kind),
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("Every %s in Jamf Pro whose name matches name_regex.", kind),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the object.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the object.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// readList fetches every object of the given kind and states the IDs and names of those matching name_regex.
+func readList(ctx context.Context, d *schema.ResourceData, meta interface{}, kind string, list func(*jamfpro.Client) ([]NamedObject, error)) diag.Diagnostics {
+	nameRegex := d.Get("name_regex").(string)
+	pattern, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid name_regex '%s': %v", nameRegex, err))
+	}
+
+	var objects []NamedObject
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		objects, apiErr = list(meta.(*jamfpro.Client))
+		if apiErr != nil {
+			return client.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list every Jamf Pro %s after retries: %v", kind, err))
+	}
+
+	items := make([]interface{}, 0, len(objects))
+	for _, object := range objects {
+		if !pattern.MatchString(object.Name) {
+			continue
+		}
+
+		items = append(items, map[string]interface{}{
+			"id":   object.ID,
+			"name": object.Name,
+		})
+	}
+
+	if nameRegex == "" {
+		d.SetId(kind)
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s", kind, nameRegex))
+	}
+	if err := d.Set("items", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// common/logging.go
+// This package contains shared / common structured logging for CRUD operations
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"regexp"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// newOperationContext returns a context carrying the fields shared by every log line of a single CRUD
+// operation, along with the generated request ID used to correlate those lines with returned errors.
+func newOperationContext(ctx context.Context, operation string, resourceType string, resourceID string) (context.Context, string) {
+	requestID := uuid.NewString()
+
+	ctx = tflog.SetField(ctx, "request_id", requestID)
+	ctx = tflog.SetField(ctx, "operation", operation)
+	if resourceType != "" {
+		ctx = tflog.SetField(ctx, "resource_type", resourceType)
+	}
+	if resourceID != "" {
+		ctx = tflog.SetField(ctx, "jamf_id", resourceID)
+	}
+
+	return ctx, requestID
+}
+
+// logAPIError logs a failed API call with the HTTP status recovered from the SDK error.
+func logAPIError(ctx context.Context, err error) {
+	tflog.Warn(ctx, "Jamf Pro API request failed", map[string]interface{}{
+		"http_status": client.StatusCode(err),
+		"error":       err.Error(),
+	})
+}
+
+// logBody logs a request or response body at TRACE level, with sensitive fields masked.
+func logBody(ctx context.Context, field string, body interface{}) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return
+	}
+
+	tflog.Trace(ctx, "Jamf Pro API "+field, map[string]interface{}{
+		field: RedactSensitiveFields(string(data)),
+	})
+}
+
+// LogConstructedPayload logs a payload serialized by a resource constructor at DEBUG level, with sensitive
+// fields masked. description names the payload, such as "Jamf Pro Building JSON".
+func LogConstructedPayload(ctx context.Context, description string, payload string) {
+	tflog.Debug(ctx, "Constructed "+description, map[string]interface{}{
+		"payload": RedactSensitiveFields(payload),
+	})
+}
+
+// sensitiveName matches the field and element names whose values are masked in logs, such as Password,
+// read_write_password, ClientSecret, passphrase or accessToken.
+const sensitiveName = `[A-Za-z_]*(?i:password|secret|passphrase|token)[A-Za-z_]*`
+
+var (
+	sensitiveJSONValue = regexp.MustCompile(`("` + sensitiveName + `"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	sensitiveXMLValue  = regexp.MustCompile(`(<` + sensitiveName + `>)[^<]+`)
+)
+
+// RedactSensitiveFields masks the values of password, secret, passphrase and token fields, at any depth, in a JSON
+// or XML payload so that it can be logged.
+func RedactSensitiveFields(body string) string {
+	body = sensitiveJSONValue.ReplaceAllString(body, `${1}"***REDACTED***"`)
+	return sensitiveXMLValue.ReplaceAllString(body, `${1}***REDACTED***`)
+}
+
+// typeName returns the name of the struct type T, used to identify resources in logs and errors.
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().Name()
+}
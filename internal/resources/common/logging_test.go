@@ -0,0 +1,131 @@
+package common
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedactSensitiveFields(t *testing.T) {
+	cases := []struct {
+		name     string
+		body     string
+		want     string
+		revealed []string
+	}{
+		{
+			name: "JSON password",
+			body: `{"username":"admin","password":"hunter2"}`,
+			want: `{"username":"admin","password":"***REDACTED***"}`,
+		},
+		{
+			name: "JSON nested client secret",
+			body: `{"auth":{"clientSecret" : "s3cr3t\"quoted"}}`,
+			want: `{"auth":{"clientSecret" : "***REDACTED***"}}`,
+		},
+		{
+			name: "JSON access token",
+			body: `{"accessToken":"abc.def","token_type":"Bearer","id":"1"}`,
+			want: `{"accessToken":"***REDACTED***","token_type":"***REDACTED***","id":"1"}`,
+		},
+		{
+			name: "JSON numeric values are left alone",
+			body: `{"tokenExpiry":3600}`,
+			want: `{"tokenExpiry":3600}`,
+		},
+		{
+			name: "XML password",
+			body: `<user><name>admin</name><password>hunter2</password></user>`,
+			want: `<user><name>admin</name><password>***REDACTED***</password></user>`,
+		},
+		{
+			name: "XML secret",
+			body: `<distribution_point><read_write_secret>s3cr3t</read_write_secret></distribution_point>`,
+			want: `<distribution_point><read_write_secret>***REDACTED***</read_write_secret></distribution_point>`,
+		},
+		{
+			name: "XML token",
+			body: `<vpp_account><service_token>abc123</service_token></vpp_account>`,
+			want: `<vpp_account><service_token>***REDACTED***</service_token></vpp_account>`,
+		},
+		{
+			name: "XML empty value",
+			body: `<webhook><password></password></webhook>`,
+			want: `<webhook><password></password></webhook>`,
+		},
+		{
+			name: "no sensitive fields",
+			body: `{"name":"Main Office","city":"Minneapolis"}`,
+			want: `{"name":"Main Office","city":"Minneapolis"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := RedactSensitiveFields(tc.body); got != tc.want {
+				t.Fatalf("RedactSensitiveFields(%s):\n got %s\nwant %s", tc.body, got, tc.want)
+			}
+		})
+	}
+}
+
+type redactionFixture struct {
+	Name         string `json:"name" xml:"name"`
+	Username     string `json:"username" xml:"username"`
+	Password     string `json:"password" xml:"password"`
+	ClientSecret string `json:"clientSecret" xml:"client_secret"`
+	ServiceToken string `json:"serviceToken" xml:"service_token"`
+	Notes        string `json:"notes" xml:"notes"`
+}
+
+func newRedactionFixture() *redactionFixture {
+	return &redactionFixture{
+		Name:         "Example",
+		Username:     "admin",
+		Password:     "hunter2",
+		ClientSecret: "s3cr3t",
+		ServiceToken: "abc123",
+		Notes:        "keep me",
+	}
+}
+
+func TestSerializeAndRedact(t *testing.T) {
+	serializers := map[string]func(interface{}, []string) (string, error){
+		"XML":  SerializeAndRedactXML,
+		"JSON": SerializeAndRedactJSON,
+	}
+
+	for format, serialize := range serializers {
+		t.Run(format, func(t *testing.T) {
+			resource := newRedactionFixture()
+
+			got, err := serialize(resource, []string{"Username"})
+			if err != nil {
+				t.Fatalf("serializing: %v", err)
+			}
+
+			for _, secret := range []string{"admin", "hunter2", "s3cr3t", "abc123"} {
+				if strings.Contains(got, secret) {
+					t.Errorf("serialized %s reveals %q:\n%s", format, secret, got)
+				}
+			}
+			for _, kept := range []string{"Example", "keep me"} {
+				if !strings.Contains(got, kept) {
+					t.Errorf("serialized %s is missing %q:\n%s", format, kept, got)
+				}
+			}
+
+			if resource.Password != "hunter2" || resource.Username != "admin" {
+				t.Errorf("serializing modified the resource: %+v", resource)
+			}
+		})
+	}
+}
+
+func TestSerializeAndRedactRejectsNonStruct(t *testing.T) {
+	if _, err := SerializeAndRedactJSON("not a struct", nil); err == nil {
+		t.Fatal("SerializeAndRedactJSON accepted a string, want an error")
+	}
+	if _, err := SerializeAndRedactXML(*newRedactionFixture(), nil); err == nil {
+		t.Fatal("SerializeAndRedactXML accepted a struct value, want an error for a non-pointer")
+	}
+}
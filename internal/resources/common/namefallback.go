@@ -0,0 +1,25 @@
+// common/namefallback.go
+// This package contains the opt-out for deleting objects by name when a delete by ID fails.
+
+package common
+
+import (
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// nameFallbackDisabledClients records the clients for which by-name delete fallbacks are turned off.
+var nameFallbackDisabledClients sync.Map
+
+// DisableNameFallback stops resources from retrying a failed delete by ID as a delete by name for the given client.
+func DisableNameFallback(client *jamfpro.Client) {
+	nameFallbackDisabledClients.Store(client, true)
+}
+
+// NameFallbackEnabled reports whether a resource may delete an object by its name after deleting it by ID failed.
+// Names are not always unique in Jamf Pro, so the fallback can remove a different object of the same name.
+func NameFallbackEnabled(client *jamfpro.Client) bool {
+	_, disabled := nameFallbackDisabledClients.Load(client)
+	return !disabled
+}
@@ -0,0 +1,112 @@
+// common/readcache.go
+// This package contains an opt-in, in-memory cache of list endpoint responses used to serve reads.
+
+package common
+
+import (
+	"log"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// readCache holds the objects returned by list endpoints, keyed by resource kind and then by ID.
+type readCache struct {
+	mu    sync.Mutex
+	kinds map[string]map[string]interface{}
+}
+
+// readCaches maps each configured client to its cache. A client without an entry has caching disabled.
+// The provider process lives for a single plan or apply, so cache contents never outlive the run.
+var readCaches sync.Map
+
+// EnableReadCache turns on bulk read caching for the given client.
+func EnableReadCache(client *jamfpro.Client) {
+	readCaches.LoadOrStore(client, &readCache{kinds: make(map[string]map[string]interface{})})
+}
+
+// getReadCache returns the cache attached to the client held in meta, or nil when caching is disabled.
+func getReadCache(meta interface{}) *readCache {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return nil
+	}
+
+	cache, ok := readCaches.Load(client)
+	if !ok {
+		return nil
+	}
+
+	return cache.(*readCache)
+}
+
+// CachedGet wraps a get-by-ID function so that, when the read cache is enabled, the first read of a kind
+// pulls the full list once and subsequent reads are served from memory. IDs missing from the list,
+// such as objects created after the list was fetched, fall through to the get-by-ID function.
+func CachedGet[T any](meta interface{}, kind string, list func() (map[string]*T, error), get sdkGetFunc[T]) sdkGetFunc[T] {
+	cache := getReadCache(meta)
+	if cache == nil {
+		return get
+	}
+
+	return func(resourceID string) (*T, error) {
+		item, ok, err := cache.lookup(kind, resourceID, func() (map[string]interface{}, error) {
+			results, err := list()
+			if err != nil {
+				return nil, err
+			}
+
+			items := make(map[string]interface{}, len(results))
+			for id, result := range results {
+				items[id] = result
+			}
+			return items, nil
+		})
+		if err != nil {
+			log.Printf("[WARN] Bulk read of %s failed, falling back to individual reads: %v", kind, err)
+		}
+
+		if ok {
+			return item.(*T), nil
+		}
+
+		return get(resourceID)
+	}
+}
+
+// lookup returns the cached object for the given kind and ID, populating the kind from load on first access.
+func (c *readCache) lookup(kind string, resourceID string, load func() (map[string]interface{}, error)) (interface{}, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	items, loaded := c.kinds[kind]
+	if !loaded {
+		var err error
+		items, err = load()
+		if err != nil {
+			return nil, false, err
+		}
+
+		c.kinds[kind] = items
+		log.Printf("[DEBUG] Cached %d %s from list endpoint", len(items), kind)
+	}
+
+	item, ok := items[resourceID]
+	return item, ok, nil
+}
+
+// EvictReadCache drops a single object from the read cache so the next read fetches it by ID.
+// Resources that use CachedGet must call this before updating or deleting the object.
+func EvictReadCache(meta interface{}, kind string, resourceID string) {
+	cache := getReadCache(meta)
+	if cache == nil {
+		return
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if items, ok := cache.kinds[kind]; ok {
+		delete(items, resourceID)
+	}
+}
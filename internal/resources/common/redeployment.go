@@ -4,18 +4,29 @@
 package common
 
 import (
+	"log"
+	"net/http"
 	"strings"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// HandleResourceNotFoundError is a helper function to handle 404 and 410 errors and remove the resource from Terraform state
+// HandleResourceNotFoundError is a helper function to handle 404 and 410 errors and remove the resource from Terraform state.
+// Only a genuine 404 Not Found or 410 Gone removes the resource; any other failure, such as an expired token (401),
+// missing privileges (403) or a server error, is returned so that it is never mistaken for a deletion.
 func HandleResourceNotFoundError(err error, d *schema.ResourceData, cleanup bool) diag.Diagnostics {
 	var diags diag.Diagnostics
-	ErrorTypeIsNotFound := strings.Contains(err.Error(), "404")
+	statusCode := client.StatusCode(err)
+	errorTypeIsNotFound := statusCode == http.StatusNotFound || statusCode == http.StatusGone
+	if statusCode == 0 {
+		// Some SDK errors only carry the status in their message text.
+		errorTypeIsNotFound = strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "410")
+	}
 
-	if cleanup && ErrorTypeIsNotFound {
+	if cleanup && errorTypeIsNotFound {
+		log.Printf("[INFO] Jamf Pro returned HTTP %d for resource ID %s; removing it from state so it is redeployed", statusCode, d.Id())
 		d.SetId("")
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Warning,
@@ -23,6 +34,7 @@ func HandleResourceNotFoundError(err error, d *schema.ResourceData, cleanup bool
 		})
 
 	} else {
+		log.Printf("[DEBUG] Keeping resource ID %s in state after read failed with HTTP status %d (cleanup: %t)", d.Id(), statusCode, cleanup)
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
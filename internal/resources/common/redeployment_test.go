@@ -0,0 +1,70 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/deploymenttheory/go-api-http-client/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sdkError returns an error formatted as the SDK reports a failed request, carrying statusCode.
+func sdkError(statusCode string) error {
+	return errors.New(`failed to get building by id: 1, error: {"status_code":` + statusCode + `,"method":"GET","message":"API Error Response"}`)
+}
+
+func TestHandleResourceNotFoundError(t *testing.T) {
+	cases := []struct {
+		name        string
+		err         error
+		cleanup     bool
+		wantRemoved bool
+	}{
+		{name: "404 with cleanup", err: sdkError("404"), cleanup: true, wantRemoved: true},
+		{name: "410 with cleanup", err: sdkError("410"), cleanup: true, wantRemoved: true},
+		{name: "404 without cleanup", err: sdkError("404")},
+		{name: "410 without cleanup", err: sdkError("410")},
+		{name: "401 expired token", err: sdkError("401"), cleanup: true},
+		{name: "403 missing privileges", err: sdkError("403"), cleanup: true},
+		{name: "500 server error", err: sdkError("500"), cleanup: true},
+		{name: "wrapped API error 404", err: fmt.Errorf("failed to get building: %w", &response.APIError{StatusCode: 404, Message: "Not Found"}), cleanup: true, wantRemoved: true},
+		{name: "wrapped API error 403", err: fmt.Errorf("failed to get building: %w", &response.APIError{StatusCode: 403, Message: "Forbidden 404"}), cleanup: true},
+		{name: "status in resty format", err: errors.New("request failed: StatusCode=404"), cleanup: true, wantRemoved: true},
+		{name: "no status, 404 in message", err: errors.New("resource not found: 404"), cleanup: true, wantRemoved: true},
+		{name: "no status, 410 in message", err: errors.New("object gone (410)"), cleanup: true, wantRemoved: true},
+		{name: "no status, 404 in message without cleanup", err: errors.New("resource not found: 404")},
+		{name: "no status, timeout", err: errors.New("context deadline exceeded"), cleanup: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]interface{}{})
+			d.SetId("1")
+
+			diags := HandleResourceNotFoundError(tc.err, d, tc.cleanup)
+
+			if len(diags) != 1 {
+				t.Fatalf("got %d diagnostics, want 1: %v", len(diags), diags)
+			}
+
+			if tc.wantRemoved {
+				if d.Id() != "" {
+					t.Fatalf("got ID %q, want the resource removed from state", d.Id())
+				}
+				if diags[0].Severity != diag.Warning {
+					t.Fatalf("got %v, want a redeployment warning", diags[0])
+				}
+				return
+			}
+
+			if d.Id() != "1" {
+				t.Fatalf("got ID %q, want the resource kept in state", d.Id())
+			}
+			if diags[0].Severity != diag.Error || diags[0].Summary != tc.err.Error() {
+				t.Fatalf("got %v, want the original error returned", diags[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// common/references.go
+// This package contains the shared pre-delete check for objects that other Jamf Pro objects refer to.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Reference is an object in Jamf Pro that refers to the object being deleted.
+type Reference struct {
+	Type string
+	Name string
+}
+
+// CheckNotReferenced returns an error diagnostic listing every object found by find that still refers to
+// the object being deleted, so the user sees what to reassign instead of a bare 409 Conflict. Lookup
+// failures are only logged; the delete then goes ahead and Jamf Pro remains the final check.
+func CheckNotReferenced(ctx context.Context, d *schema.ResourceData, kind string, find func() ([]Reference, error)) diag.Diagnostics {
+	references, err := find()
+	if err != nil {
+		tflog.Warn(ctx, "Could not check for objects referencing "+kind+" before deleting it", map[string]interface{}{
+			"jamf_id": d.Id(),
+			"error":   err.Error(),
+		})
+		return nil
+	}
+
+	if len(references) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(references))
+	for _, reference := range references {
+		lines = append(lines, fmt.Sprintf("  - %s '%s'", reference.Type, reference.Name))
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("The %s is still in use", kind),
+		Detail: fmt.Sprintf("%s '%s' (ID: %s) cannot be deleted while these objects refer to it:\n%s\nReassign or delete them first.",
+			strings.ToUpper(kind[:1])+kind[1:], d.Get("name").(string), d.Id(), strings.Join(lines, "\n")),
+	}}
+}
@@ -0,0 +1,54 @@
+// common/sharedschemas/criteria.go
+package sharedschemas
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// regexSearchTypes are the criteria search types whose value is a regular expression.
+var regexSearchTypes = map[string]bool{
+	"matches regex":        true,
+	"does not match regex": true,
+}
+
+// ValidateCriteriaRegex returns an error if a criterion with a regex search type has a value that does not compile.
+// Jamf Pro evaluates these with the database's regex engine rather than Go's, so this only catches patterns that
+// are broken in any engine, such as unbalanced brackets, which Jamf Pro would otherwise save and never match.
+func ValidateCriteriaRegex(criteria []interface{}) error {
+	for i, v := range criteria {
+		criterion, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		searchType, _ := criterion["search_type"].(string)
+		if !regexSearchTypes[searchType] {
+			continue
+		}
+
+		value, _ := criterion["value"].(string)
+		if _, err := regexp.Compile(value); err != nil {
+			return fmt.Errorf("criteria.%d ('%s'): value '%s' is not a valid regular expression for search_type '%s': %v", i, criterion["name"], value, searchType, err)
+		}
+	}
+
+	return nil
+}
+
+// SortCriteriaByPriority orders flattened criteria by their priority field, so that state does not depend on the
+// order in which Jamf Pro returns them. Criteria with equal priority keep their relative order.
+func SortCriteriaByPriority(criteria []interface{}) []interface{} {
+	priority := func(i int) int {
+		criterion, _ := criteria[i].(map[string]interface{})
+		p, _ := criterion["priority"].(int)
+		return p
+	}
+
+	sort.SliceStable(criteria, func(i, j int) bool {
+		return priority(i) < priority(j)
+	})
+
+	return criteria
+}
@@ -0,0 +1,53 @@
+package sharedschemas
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortCriteriaByPriority(t *testing.T) {
+	criterion := func(name string, priority int) interface{} {
+		return map[string]interface{}{"name": name, "priority": priority}
+	}
+	names := func(criteria []interface{}) []string {
+		var out []string
+		for _, c := range criteria {
+			out = append(out, c.(map[string]interface{})["name"].(string))
+		}
+		return out
+	}
+
+	cases := []struct {
+		name     string
+		criteria []interface{}
+		want     []string
+	}{
+		{
+			name:     "already in order",
+			criteria: []interface{}{criterion("Model", 0), criterion("Department", 1)},
+			want:     []string{"Model", "Department"},
+		},
+		{
+			name:     "returned in reverse",
+			criteria: []interface{}{criterion("Building", 2), criterion("Department", 1), criterion("Model", 0)},
+			want:     []string{"Model", "Department", "Building"},
+		},
+		{
+			name:     "equal priorities keep their order",
+			criteria: []interface{}{criterion("Building", 1), criterion("Model", 0), criterion("Department", 1)},
+			want:     []string{"Model", "Building", "Department"},
+		},
+		{
+			name:     "no criteria",
+			criteria: []interface{}{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := names(SortCriteriaByPriority(tc.criteria)); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// common/sharedschemas/criteriagroups.go
+package sharedschemas
+
+// CompileCriteriaGroups flattens criteria_group blocks into the criterion list sent to Jamf Pro. Each group of more
+// than one criterion is wrapped in parentheses, the first criterion of a group takes the group's and_or, and
+// priorities are numbered from 0 in the order written. Jamf Pro marks parentheses with a flag per criterion, so
+// groups cannot be nested.
+func CompileCriteriaGroups(groups []interface{}) []interface{} {
+	var criteria []interface{}
+
+	for _, g := range groups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		members, _ := group["criterion"].([]interface{})
+		for i, m := range members {
+			member, ok := m.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			andOr, _ := member["and_or"].(string)
+			if i == 0 {
+				andOr, _ = group["and_or"].(string)
+			}
+
+			criteria = append(criteria, map[string]interface{}{
+				"name":          member["name"],
+				"priority":      len(criteria),
+				"and_or":        andOr,
+				"search_type":   member["search_type"],
+				"value":         member["value"],
+				"opening_paren": len(members) > 1 && i == 0,
+				"closing_paren": len(members) > 1 && i == len(members)-1,
+			})
+		}
+	}
+
+	return criteria
+}
+
+// DecompileCriteriaGroups rebuilds criteria_group blocks from the flat, priority-ordered criteria returned by Jamf Pro.
+// Criteria between an opening and closing parenthesis form one group, and every other criterion is a group of its own.
+func DecompileCriteriaGroups(criteria []interface{}) []interface{} {
+	var groups []interface{}
+	var members []interface{}
+	groupAndOr := ""
+	inParens := false
+
+	flush := func() {
+		if len(members) > 0 {
+			groups = append(groups, map[string]interface{}{
+				"and_or":    groupAndOr,
+				"criterion": members,
+			})
+		}
+		members = nil
+		inParens = false
+	}
+
+	for _, c := range criteria {
+		criterion, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		opening, _ := criterion["opening_paren"].(bool)
+		closing, _ := criterion["closing_paren"].(bool)
+		andOr, _ := criterion["and_or"].(string)
+
+		if !inParens || opening {
+			flush()
+			groupAndOr = andOr
+			andOr = "and"
+			inParens = opening && !closing
+		} else if closing {
+			inParens = false
+		}
+
+		members = append(members, map[string]interface{}{
+			"name":        criterion["name"],
+			"and_or":      andOr,
+			"search_type": criterion["search_type"],
+			"value":       criterion["value"],
+		})
+
+		if !inParens {
+			flush()
+		}
+	}
+	flush()
+
+	return groups
+}
@@ -0,0 +1,14 @@
+package sharedschemas
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func GetSharedSchemaAllowDeletion() *schema.Schema {
+	out := &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Whether Terraform may delete this object. When false, destroying or replacing the resource fails until this is set to true and applied. Use it to protect objects that other configuration depends on.",
+	}
+
+	return out
+}
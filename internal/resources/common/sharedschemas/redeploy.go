@@ -0,0 +1,24 @@
+package sharedschemas
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// RedeployToAll is the redeploy_on_update value that makes Jamf Pro push a saved profile to every scoped device.
+const RedeployToAll = "All"
+
+func GetSharedSchemaRedeployOnTrigger() *schema.Schema {
+	out := &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: "An arbitrary value that forces the configuration profile to be pushed to all scoped devices " +
+			"again when it changes, even if the profile itself is unchanged. Bump it, for example to a date or " +
+			"ticket number, to remediate devices. Has no effect when the profile is first created.",
+	}
+
+	return out
+}
+
+// RedeployTriggered reports whether redeploy_on_trigger changed on an existing profile, in which case the
+// update should be sent with redeploy_on_update set to RedeployToAll.
+func RedeployTriggered(d *schema.ResourceData) bool {
+	return !d.IsNewResource() && d.HasChange("redeploy_on_trigger")
+}
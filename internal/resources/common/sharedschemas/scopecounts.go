@@ -0,0 +1,41 @@
+package sharedschemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func GetSharedSchemaTargetedGroupCount() *schema.Schema {
+	out := &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+		Description: "The number of device groups in the scope, as read back from Jamf Pro. Check all_computers or " +
+			"all_mobile_devices as well, which target every device regardless of this count.",
+	}
+
+	return out
+}
+
+func GetSharedSchemaExcludedGroupCount() *schema.Schema {
+	out := &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "The number of device groups excluded from the scope, as read back from Jamf Pro.",
+	}
+
+	return out
+}
+
+// StateScopeGroupCounts sets targeted_group_count and excluded_group_count from the scope read from Jamf Pro.
+func StateScopeGroupCounts(d *schema.ResourceData, targeted int, excluded int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := d.Set("targeted_group_count", targeted); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("excluded_group_count", excluded); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,49 @@
+// common/sharedschemas/scopeexclusions.go
+package sharedschemas
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// scopeExclusionsPath is the address of the exclusions block within a resource's scope block.
+const scopeExclusionsPath = "scope.0.exclusions"
+
+// GetScopeExclusions returns the configured exclusions block from the scope data, if any. An empty
+// `exclusions {}` block is returned as an empty map rather than nil, so callers can range over it safely.
+func GetScopeExclusions(scope map[string]interface{}) (map[string]interface{}, bool) {
+	exclusions, ok := scope["exclusions"].([]interface{})
+	if !ok || len(exclusions) == 0 {
+		return nil, false
+	}
+
+	if exclusionMap, ok := exclusions[0].(map[string]interface{}); ok {
+		return exclusionMap, true
+	}
+
+	return map[string]interface{}{}, true
+}
+
+// StateScopeExclusions returns the exclusions block to state from the flattened exclusion lists, dropping
+// empty lists. When Jamf Pro reports no exclusions at all the block is only kept if it is already present
+// in state, so configurations with an empty exclusions block round-trip without a diff.
+func StateScopeExclusions(d *schema.ResourceData, exclusions map[string]interface{}) []map[string]interface{} {
+	result := make(map[string]interface{}, len(exclusions))
+	for field, value := range exclusions {
+		switch list := value.(type) {
+		case []int:
+			if len(list) > 0 {
+				result[field] = list
+			}
+		case []string:
+			if len(list) > 0 {
+				result[field] = list
+			}
+		}
+	}
+
+	if len(result) == 0 {
+		if existing, ok := d.Get(scopeExclusionsPath).([]interface{}); !ok || len(existing) == 0 {
+			return nil
+		}
+	}
+
+	return []map[string]interface{}{result}
+}
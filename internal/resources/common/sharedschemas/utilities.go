@@ -22,10 +22,7 @@ func ExtractNestedObjectsFromSchema[NestedObjectType any, ListItemPrimitiveType
 		return nil
 	}
 
-	attrList, ok := getAttr.([]interface{})
-	if !ok {
-		return fmt.Errorf("failed to cast %s to []interface{}", path)
-	}
+	attrList := ListOrSetItems(getAttr)
 
 	if len(attrList) == 0 {
 		return nil
@@ -58,3 +55,15 @@ func ExtractNestedObjectsFromSchema[NestedObjectType any, ListItemPrimitiveType
 
 	return nil
 }
+
+// ListOrSetItems returns the elements of a list or set attribute value, or nil for any other value. Scope
+// ID fields are sets, so that plans show the IDs added and removed; this lets callers read them like lists.
+func ListOrSetItems(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// common/uniquenames.go
+// This package contains an opt-in plan-time check that resource names are unique in Jamf Pro.
+
+package common
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// uniqueNameClients records the clients for which name uniqueness checks are enabled.
+var uniqueNameClients sync.Map
+
+// EnableUniqueNameChecks turns on plan-time name uniqueness checks for the given client.
+func EnableUniqueNameChecks(client *jamfpro.Client) {
+	uniqueNameClients.Store(client, true)
+}
+
+// NamedObject is the ID and name of an object returned by a list endpoint.
+type NamedObject struct {
+	ID   string
+	Name string
+}
+
+// ValidateUniqueName returns an error if creating or renaming the resource would give it the same name
+// as another object of the same kind in Jamf Pro. It does nothing unless uniqueness checks are enabled,
+// the name is known at plan time, and the resource is new or its name is changing.
+func ValidateUniqueName(diff *schema.ResourceDiff, meta interface{}, kind string, list func(*jamfpro.Client) ([]NamedObject, error)) error {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return nil
+	}
+
+	if _, enabled := uniqueNameClients.Load(client); !enabled {
+		return nil
+	}
+
+	if !diff.NewValueKnown("name") || (diff.Id() != "" && !diff.HasChange("name")) {
+		return nil
+	}
+
+	name := diff.Get("name").(string)
+
+	objects, err := list(client)
+	if err != nil {
+		return fmt.Errorf("failed to list %s to check that name '%s' is unique: %v", kind, name, err)
+	}
+
+	for _, object := range objects {
+		if DecodeName(object.Name) == name && object.ID != diff.Id() {
+			return fmt.Errorf("a %s named '%s' already exists in Jamf Pro (ID: %s); names must be unique", kind, name, object.ID)
+		}
+	}
+
+	return nil
+}
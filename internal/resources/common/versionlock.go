@@ -0,0 +1,45 @@
+// common/versionlock.go
+// This package contains update support for Jamf Pro API objects guarded by an optimistic version lock.
+
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// versionLockFunc returns the version lock currently held by Jamf Pro for an object.
+type versionLockFunc func(resourceID string) (int, error)
+
+// UpdateWithVersionLock behaves like Update for objects that carry a version_lock attribute. If Jamf Pro rejects
+// the update with 409 Conflict because another client changed the object since it was read, the current lock is
+// fetched with currentVersionLock and the update is retried once with the new lock.
+func UpdateWithVersionLock[sdkPayloadType any, sdkResponseType any](
+	ctx context.Context,
+	d *schema.ResourceData,
+	meta interface{},
+	constructor payoadConstructorFunc[sdkPayloadType],
+	outcomeFunc sdkUpdateFunc[sdkPayloadType, sdkResponseType],
+	reader providerReadFunc,
+	currentVersionLock func(resourceID string) (int, error),
+) diag.Diagnostics {
+	return update(ctx, d, meta, constructor, outcomeFunc, reader, currentVersionLock)
+}
+
+// refreshVersionLock stores the object's current version lock in version_lock so the payload can be rebuilt with it.
+func refreshVersionLock(ctx context.Context, d *schema.ResourceData, currentVersionLock versionLockFunc) error {
+	versionLock, err := currentVersionLock(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "Version lock conflict, retrying update with the current lock", map[string]interface{}{
+		"stale_version_lock":   d.Get("version_lock"),
+		"current_version_lock": versionLock,
+	})
+
+	return d.Set("version_lock", versionLock)
+}
@@ -0,0 +1,78 @@
+// waitfor/resource.go
+// This package contains helpers that wait for Jamf Pro objects to become consistent after a change.
+
+package waitfor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// propagationTimeout overrides the create timeout as the limit for availability waits when non-zero.
+var propagationTimeout atomic.Int64
+
+// SetPropagationTimeout sets how long ResourceIsAvailable waits for a new object, overriding the resource's create timeout.
+// A zero duration restores the create timeout.
+func SetPropagationTimeout(timeout time.Duration) {
+	propagationTimeout.Store(int64(timeout))
+}
+
+// Timeout returns the configured propagation timeout, falling back to the resource's create timeout.
+func Timeout(d *schema.ResourceData) time.Duration {
+	if timeout := time.Duration(propagationTimeout.Load()); timeout > 0 {
+		return timeout
+	}
+	return d.Timeout(schema.TimeoutCreate)
+}
+
+// ResourceIsAvailable polls getFunc, backing off exponentially, until the object with the given ID can be read
+// or the timeout elapses. Jamf Pro can accept a create before the new object is queryable, so reading
+// immediately may return 404. Errors that cannot be resolved by waiting are returned straight away.
+func ResourceIsAvailable[T any](ctx context.Context, d *schema.ResourceData, typeName string, resourceID string, getFunc func(string) (*T, error), timeout time.Duration) diag.Diagnostics {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	attempts := 0
+	for {
+		attempts++
+		_, err := getFunc(resourceID)
+		if err == nil {
+			if attempts > 1 {
+				tflog.Debug(ctx, fmt.Sprintf("%s became available", typeName), map[string]interface{}{"jamf_id": resourceID, "attempts": attempts})
+			}
+			return nil
+		}
+
+		statusCode := client.StatusCode(err)
+		if statusCode != http.StatusNotFound && !client.IsRetryable(err) {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Failed to confirm %s was created", typeName),
+				Detail:   fmt.Sprintf("Reading %s (ID: %s) after create failed with a non-retryable error: %v", typeName, resourceID, err),
+			}}
+		}
+
+		interval := common.DefaultBackoff.Delay(attempts - 1)
+		tflog.Debug(ctx, fmt.Sprintf("%s is not yet available", typeName), map[string]interface{}{"jamf_id": resourceID, "http_status": statusCode, "retry_in": interval.String()})
+
+		select {
+		case <-ctx.Done():
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%s was created but is not yet available", typeName),
+				Detail: fmt.Sprintf("%s (ID: %s) could not be read within %s after %d attempts. The object exists in Jamf Pro and is recorded in state as tainted; "+
+					"run terraform untaint once it has propagated to keep it, or raise propagation_timeout_seconds. Last error: %v", typeName, resourceID, timeout, attempts, err),
+			}}
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// common/xmldiff.go
+// This package contains diff suppression for attributes holding XML documents that Jamf Pro re-serialises.
+
+package common
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DiffSuppressXML suppresses diffs between two XML documents that differ only in formatting, such as
+// identity provider metadata pasted from a download or a managed app configuration plist that Jamf Pro
+// stores with its own indentation. Values that are not well-formed XML are compared as-is.
+func DiffSuppressXML(k, old, new string, d *schema.ResourceData) bool {
+	normalizedOld, err := NormalizeXML(old)
+	if err != nil {
+		log.Printf("[DEBUG] Error normalizing old value for '%s': %v", k, err)
+		return false
+	}
+
+	normalizedNew, err := NormalizeXML(new)
+	if err != nil {
+		log.Printf("[DEBUG] Error normalizing new value for '%s': %v", k, err)
+		return false
+	}
+
+	return normalizedOld == normalizedNew
+}
+
+// NormalizeXML re-encodes an XML document with whitespace-only character data, comments, directives and
+// declarations removed, so that two semantically equal documents produce the same string. Non-whitespace
+// text, such as a signing certificate or a plist value, is kept verbatim. An error is returned if the
+// document is not well-formed.
+func NormalizeXML(input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return "", nil
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(input))
+	var buf bytes.Buffer
+	encoder := xml.NewEncoder(&buf)
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch t := token.(type) {
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) == 0 {
+				continue
+			}
+		case xml.Comment, xml.Directive, xml.ProcInst:
+			continue
+		}
+
+		if err := encoder.EncodeToken(xml.CopyToken(token)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := encoder.Flush(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
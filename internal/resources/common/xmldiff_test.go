@@ -0,0 +1,68 @@
+package common
+
+import "testing"
+
+func TestDiffSuppressXML(t *testing.T) {
+	const metadata = `<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com">
+  <!-- signing key -->
+  <md:IDPSSODescriptor>
+    <ds:X509Certificate xmlns:ds="http://www.w3.org/2000/09/xmldsig#">MIIC8DCCAdigAwIBAgIQ</ds:X509Certificate>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+
+	cases := []struct {
+		name     string
+		old      string
+		new      string
+		suppress bool
+	}{
+		{
+			name:     "identical",
+			old:      metadata,
+			new:      metadata,
+			suppress: true,
+		},
+		{
+			name:     "indentation, declaration and comments",
+			old:      metadata,
+			new:      `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://idp.example.com"><md:IDPSSODescriptor><ds:X509Certificate xmlns:ds="http://www.w3.org/2000/09/xmldsig#">MIIC8DCCAdigAwIBAgIQ</ds:X509Certificate></md:IDPSSODescriptor></md:EntityDescriptor>`,
+			suppress: true,
+		},
+		{
+			name:     "plist line endings",
+			old:      "<dict>\r\n\t<key>Server</key>\r\n\t<string>mail.example.com</string>\r\n</dict>",
+			new:      "<dict>\n  <key>Server</key>\n  <string>mail.example.com</string>\n</dict>\n",
+			suppress: true,
+		},
+		{
+			name: "changed text",
+			old:  "<dict><key>Server</key><string>mail.example.com</string></dict>",
+			new:  "<dict><key>Server</key><string>smtp.example.com</string></dict>",
+		},
+		{
+			name: "whitespace inside a value is significant",
+			old:  "<dict><key>Server</key><string>mail.example.com</string></dict>",
+			new:  "<dict><key>Server</key><string> mail.example.com</string></dict>",
+		},
+		{
+			name:     "both empty",
+			old:      "",
+			new:      "  \n",
+			suppress: true,
+		},
+		{
+			name: "malformed new value",
+			old:  "<dict></dict>",
+			new:  "<dict>",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := DiffSuppressXML("metadata", tc.old, tc.new, nil); got != tc.suppress {
+				t.Fatalf("DiffSuppressXML() = %t, want %t", got, tc.suppress)
+			}
+		})
+	}
+}
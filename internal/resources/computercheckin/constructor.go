@@ -2,16 +2,17 @@
 package computercheckin
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProComputerCheckin constructs a ResourceComputerCheckin object from the provided schema data and logs its XML representation.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerCheckin, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceComputerCheckin, error) {
 	resource := &jamfpro.ResourceComputerCheckin{
 		CheckInFrequency:          d.Get("check_in_frequency").(int),
 		CreateStartupScript:       d.Get("create_startup_script").(bool),
@@ -22,6 +23,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerCheckin, error)
 		CreateLoginLogoutHooks:        d.Get("create_login_logout_hooks").(bool),
 		LogUsername:                   d.Get("log_username").(bool),
 		CheckForPoliciesAtLoginLogout: d.Get("check_for_policies_at_login_logout").(bool),
+		DisplayStatusToUser:           d.Get("display_status_to_user").(bool),
 		// Note: "apply_user_level_managed_preferences", "hide_restore_partition", and "perform_login_actions_in_background" are computed, not set directly
 	}
 
@@ -31,7 +33,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerCheckin, error)
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Computer Checkin to XML: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Computer Checkin XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Computer Checkin XML", string(resourceXML))
 
 	return resource, nil
 }
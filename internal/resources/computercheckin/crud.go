@@ -11,12 +11,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// singletonID is the fixed ID of the one computer check-in configuration in Jamf Pro.
+const singletonID = "jamfpro_computer_checkin_singleton"
+
 // create is responsible for initializing the Jamf Pro computer check-in configuration in Terraform.
 func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	resource, err := construct(d)
+	resource, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Computer Check-In for update: %v", err))
 	}
@@ -33,7 +36,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		return diag.FromErr(fmt.Errorf("failed to apply Jamf Pro Computer Check-In configuration after retries: %v", err))
 	}
 
-	d.SetId("jamfpro_computer_checkin_singleton")
+	d.SetId(singletonID)
 
 	return append(diags, readNoCleanup(ctx, d, meta)...)
 }
@@ -43,7 +46,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	d.SetId("jamfpro_computer_checkin_singleton")
+	d.SetId(singletonID)
 
 	var response *jamfpro.ResourceComputerCheckin
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
@@ -77,7 +80,7 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	checkinConfig, err := construct(d)
+	checkinConfig, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Computer Check-In for update: %v", err))
 	}
@@ -94,7 +97,7 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		return diag.FromErr(fmt.Errorf("failed to apply Jamf Pro Computer Check-In configuration after retries: %v", err))
 	}
 
-	d.SetId("jamfpro_computer_checkin_singleton")
+	d.SetId(singletonID)
 
 	return append(diags, readNoCleanup(ctx, d, meta)...)
 }
@@ -103,7 +106,23 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 // Since this resource represents a configuration and not an actual entity that can be deleted,
 // this function will simply remove it from the Terraform state.
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Computer check-in settings removed from state only",
+		Detail:   "Jamf Pro always has a computer check-in configuration, so the current settings stay in place until they are changed in Jamf Pro or managed by Terraform again.",
+	})
+
 	d.SetId("")
 
-	return nil
+	return diags
+}
+
+// importState adopts the computer check-in configuration whatever ID is given on import,
+// as there is only one per Jamf Pro instance.
+func importState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(singletonID)
+
+	return []*schema.ResourceData{d}, nil
 }
@@ -26,7 +26,7 @@ func ResourceJamfProComputerCheckin() *schema.Resource {
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importState,
 		},
 		Schema: map[string]*schema.Schema{
 			"check_in_frequency": {
@@ -75,6 +75,11 @@ func ResourceJamfProComputerCheckin() *schema.Resource {
 				Optional:    true,
 				Description: "Checks for policies at login and logout.",
 			},
+			"display_status_to_user": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Shows users a status window while login and logout actions run.",
+			},
 			"apply_user_level_managed_preferences": {
 				Type:        schema.TypeBool,
 				Computed:    true,
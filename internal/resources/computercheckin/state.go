@@ -24,6 +24,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceComputerCheckin)
 		"apply_user_level_managed_preferences":     resp.ApplyUserLevelManagedPreferences,
 		"hide_restore_partition":                   resp.HideRestorePartition,
 		"perform_login_actions_in_background":      resp.PerformLoginActionsInBackground,
+		"display_status_to_user":                   resp.DisplayStatusToUser,
 	}
 
 	for key, val := range checkinData {
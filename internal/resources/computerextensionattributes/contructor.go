@@ -1,19 +1,24 @@
 package computerextensionattributes
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // construct builds a ResourceComputerExtensionAttribute object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerExtensionAttribute, error) {
+// The same full object is sent on create and update: the Jamf Pro API only offers PUT for computer
+// extension attributes, which replaces every field, so a payload of only the changed fields would
+// clear the rest.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceComputerExtensionAttribute, error) {
 	resource := &jamfpro.ResourceComputerExtensionAttribute{
 		Name:                 d.Get("name").(string),
-		Description:          d.Get("description").(string),
+		Description:          normalizeDescription(d.Get("description").(string)),
 		DataType:             d.Get("data_type").(string),
 		Enabled:              jamfpro.BoolPtr(d.Get("enabled").(bool)),
 		InventoryDisplayType: d.Get("inventory_display_type").(string),
@@ -21,7 +26,9 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerExtensionAttrib
 	}
 
 	if v, ok := d.GetOk("script_contents"); ok {
-		resource.ScriptContents = v.(string)
+		// Scripts written on Windows or rendered by templatefile may use CRLF or start with a byte order mark,
+		// both of which break the interpreter line on macOS.
+		resource.ScriptContents = strings.TrimPrefix(strings.ReplaceAll(v.(string), "\r\n", "\n"), byteOrderMark)
 	}
 
 	if v, ok := d.GetOk("popup_menu_choices"); ok {
@@ -50,7 +57,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerExtensionAttrib
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Computer Extension Attribute to JSON: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Computer Extension Attribute JSON:\n%s\n", string(resourceJSON))
+	common.LogConstructedPayload(ctx, "Jamf Pro Computer Extension Attribute JSON", string(resourceJSON))
 
 	return resource, nil
 }
@@ -90,3 +97,8 @@ func validateInputType(resource *jamfpro.ResourceComputerExtensionAttribute) err
 
 	return nil
 }
+
+// Render returns the computer extension attribute payload built from d without calling Jamf Pro.
+func Render(ctx context.Context, d *schema.ResourceData) (interface{}, error) {
+	return construct(ctx, d)
+}
@@ -0,0 +1,26 @@
+package computerextensionattributes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestConstructScriptLineEndings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceJamfProComputerExtensionAttributes().Schema, map[string]interface{}{
+		"name":            "Example Present",
+		"enabled":         true,
+		"input_type":      "SCRIPT",
+		"script_contents": byteOrderMark + "#!/bin/zsh\r\necho \"<result>Yes</result>\"\r\n",
+	})
+
+	resource, err := construct(context.Background(), d)
+	if err != nil {
+		t.Fatalf("construct: %v", err)
+	}
+
+	if want := "#!/bin/zsh\necho \"<result>Yes</result>\"\n"; resource.ScriptContents != want {
+		t.Fatalf("got script %q, want %q", resource.ScriptContents, want)
+	}
+}
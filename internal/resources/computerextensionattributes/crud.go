@@ -2,15 +2,31 @@ package computerextensionattributes
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // create is responsible for creating a new Jamf Pro Computer Extension Attribute in the remote system.
+// When adopt_existing is set and an attribute with the same name already exists, that attribute is taken
+// into state and updated to match the configuration instead.
 func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("adopt_existing").(bool) {
+		existingID, err := findByName(meta.(*jamfpro.Client), d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if existingID != "" {
+			tflog.Info(ctx, "Adopting existing Jamf Pro Computer Extension Attribute with the same name", map[string]interface{}{"jamf_id": existingID})
+			d.SetId(existingID)
+			return update(ctx, d, meta)
+		}
+	}
+
 	return common.Create(
 		ctx,
 		d,
@@ -28,7 +44,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup
 		d,
 		meta,
 		cleanup,
-		meta.(*jamfpro.Client).GetComputerExtensionAttributeByID,
+		common.CachedGet(meta, cacheKind, listComputerExtensionAttributes(meta), meta.(*jamfpro.Client).GetComputerExtensionAttributeByID),
 		updateState,
 	)
 }
@@ -45,6 +61,7 @@ func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}
 
 // update is responsible for updating an existing Jamf Pro Computer Extension Attribute on the remote system.
 func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	common.EvictReadCache(meta, cacheKind, d.Id())
 	return common.Update(
 		ctx,
 		d,
@@ -58,10 +75,50 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 // delete is responsible for deleting a Jamf Pro Computer Extension Attribute.
 
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := common.CheckDeletionAllowed(d); diags.HasError() {
+		return diags
+	}
+
+	common.EvictReadCache(meta, cacheKind, d.Id())
+	client := meta.(*jamfpro.Client)
 	return common.Delete(
 		ctx,
 		d,
 		meta,
-		meta.(*jamfpro.Client).DeleteComputerExtensionAttributeByID,
+		common.BatchedDelete(meta, cacheKind, client.DeleteMultipleComputerExtensionAttributeByID, client.DeleteComputerExtensionAttributeByID),
 	)
 }
+
+// findByName returns the ID of the computer extension attribute with the given name, or "" if there is none.
+func findByName(client *jamfpro.Client, name string) (string, error) {
+	objects, err := listNames(client)
+	if err != nil {
+		return "", fmt.Errorf("failed to list computer extension attributes to look for an existing '%s' to adopt: %v", name, err)
+	}
+
+	for _, object := range objects {
+		if common.DecodeName(object.Name) == name {
+			return object.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// cacheKind identifies computer extension attributes in the provider's bulk read cache.
+const cacheKind = "computer extension attributes"
+
+// listComputerExtensionAttributes returns a loader for the bulk read cache that fetches every computer extension attribute in one paginated call.
+func listComputerExtensionAttributes(meta interface{}) func() (map[string]*jamfpro.ResourceComputerExtensionAttribute, error) {
+	return func() (map[string]*jamfpro.ResourceComputerExtensionAttribute, error) {
+		response, err := meta.(*jamfpro.Client).GetComputerExtensionAttributes("")
+		if err != nil {
+			return nil, err
+		}
+
+		attributes := make(map[string]*jamfpro.ResourceComputerExtensionAttribute, len(response.Results))
+		for i := range response.Results {
+			attributes[response.Results[i].ID] = &response.Results[i]
+		}
+		return attributes, nil
+	}
+}
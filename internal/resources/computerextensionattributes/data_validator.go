@@ -0,0 +1,194 @@
+package computerextensionattributes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// customDiffComputerExtensionAttributes performs plan-time validation of the Jamf Pro Computer Extension Attribute resource.
+func customDiffComputerExtensionAttributes(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validatePopupMenuChoices(diff); err != nil {
+		return err
+	}
+
+	if err := diffScriptFile(diff); err != nil {
+		return err
+	}
+
+	warnScriptPlatform(ctx, diff)
+	warnScriptSize(ctx, diff)
+	warnReservedName(ctx, diff)
+
+	// script_sha256 is read back from Jamf Pro, so mark it unknown whenever the script is changing.
+	if diff.HasChange("script_contents") {
+		if err := diff.SetNewComputed("script_sha256"); err != nil {
+			return err
+		}
+	}
+
+	// An attribute with the same name is expected when it is being adopted.
+	if diff.Id() == "" && diff.Get("adopt_existing").(bool) {
+		return nil
+	}
+
+	return common.ValidateUniqueName(diff, meta, "computer extension attribute", listNames)
+}
+
+// allInventoryDisplayTypes lists every inventory section an extension attribute can be displayed in.
+var allInventoryDisplayTypes = []string{"GENERAL", "HARDWARE", "OPERATING_SYSTEM", "USER_AND_LOCATION", "PURCHASING", "EXTENSION_ATTRIBUTES"}
+
+// listNames returns the ID and name of every computer extension attribute in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetComputerExtensionAttributes("")
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Results))
+	for _, item := range response.Results {
+		objects = append(objects, common.NamedObject{ID: item.ID, Name: item.Name})
+	}
+	return objects, nil
+}
+
+// validatePopupMenuChoices checks that every pop-up menu choice can be stored as the selected data_type.
+func validatePopupMenuChoices(diff *schema.ResourceDiff) error {
+	// Choices built from other resources may be partly unknown at plan time; those are checked at apply.
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !diff.NewValueKnown("data_type") || !rawConfig.GetAttr("popup_menu_choices").IsWhollyKnown() {
+		return nil
+	}
+
+	dataType := diff.Get("data_type").(string)
+	choices := diff.Get("popup_menu_choices").([]interface{})
+
+	for i, v := range choices {
+		choice, _ := v.(string)
+		if err := common.ValidateExtensionAttributeChoice(dataType, choice); err != nil {
+			return fmt.Errorf("popup_menu_choices.%d: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+// diffScriptFile plans script_contents from script_file_path so that changes to the file, and changes made
+// to the script in Jamf Pro, both show as a diff. script_contents is computed to allow this, so it is also
+// planned as empty when neither field is configured.
+func diffScriptFile(diff *schema.ResourceDiff) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !diff.NewValueKnown("script_file_path") {
+		return nil
+	}
+
+	current := normalizeScript(diff.Get("script_contents").(string))
+
+	path := diff.Get("script_file_path").(string)
+	if path == "" {
+		if rawConfig.GetAttr("script_contents").IsNull() && current != "" {
+			return diff.SetNew("script_contents", "")
+		}
+		return nil
+	}
+
+	content, err := readScriptFile(path)
+	if err != nil {
+		return err
+	}
+
+	if content != current {
+		return diff.SetNew("script_contents", content)
+	}
+
+	return nil
+}
+
+// windowsScriptMarkers are first-line markers of scripts written for Windows rather than macOS.
+var windowsScriptMarkers = []string{"@echo off", "powershell.exe", "cmd.exe", "cscript", "wscript"}
+
+// warnScriptPlatform logs a warning when a SCRIPT extension attribute looks like it was written for the wrong
+// platform. Computer extension attribute scripts run on macOS, so the script should start with a shebang and
+// not with a Windows interpreter. This is a soft check: the plan is never blocked.
+func warnScriptPlatform(ctx context.Context, diff *schema.ResourceDiff) {
+	if !diff.NewValueKnown("input_type") || !diff.NewValueKnown("script_contents") || diff.Get("input_type").(string) != "SCRIPT" {
+		return
+	}
+
+	script := strings.TrimSpace(diff.Get("script_contents").(string))
+	if script == "" {
+		return
+	}
+
+	firstLine := strings.ToLower(strings.SplitN(script, "\n", 2)[0])
+
+	reason := ""
+	if !strings.HasPrefix(firstLine, "#!") {
+		reason = "it does not start with a shebang such as #!/bin/zsh"
+	}
+	for _, marker := range windowsScriptMarkers {
+		if strings.Contains(firstLine, marker) {
+			reason = fmt.Sprintf("its first line references the Windows interpreter '%s'", marker)
+			break
+		}
+	}
+
+	if reason != "" {
+		tflog.Warn(ctx, fmt.Sprintf("Computer extension attribute '%s' runs on macOS, but %s; check that the right script was used", diff.Get("name").(string), reason))
+	}
+}
+
+// defaultScriptSizeWarningBytes is the threshold used when script_size_warning_bytes is not configured.
+const defaultScriptSizeWarningBytes = 100 * 1024
+
+// warnScriptSize logs a warning when a SCRIPT extension attribute is larger than script_size_warning_bytes.
+// Jamf Pro fails to save very large scripts with an error that does not mention their size.
+func warnScriptSize(ctx context.Context, diff *schema.ResourceDiff) {
+	threshold := defaultScriptSizeWarningBytes
+	if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr("script_size_warning_bytes").IsNull() {
+		threshold = diff.Get("script_size_warning_bytes").(int)
+	}
+
+	if threshold == 0 || !diff.NewValueKnown("input_type") || !diff.NewValueKnown("script_contents") || diff.Get("input_type").(string) != "SCRIPT" {
+		return
+	}
+
+	size := len(diff.Get("script_contents").(string))
+	if size > threshold {
+		tflog.Warn(ctx, fmt.Sprintf("Computer extension attribute '%s' has a %d byte script, over the %d byte script_size_warning_bytes threshold; "+
+			"Jamf Pro may fail to save it, so consider deploying the logic as a script with a policy and calling that from the extension attribute",
+			diff.Get("name").(string), size, threshold))
+	}
+}
+
+// reservedInventoryFieldNames are built-in computer inventory fields. An extension attribute with one of these
+// names appears alongside the built-in field in reports, smart group criteria and advanced searches, where the
+// two cannot be told apart.
+var reservedInventoryFieldNames = []string{
+	"Asset Tag", "Bar Code 1", "Bar Code 2", "Building", "Computer Name", "Department", "Email Address",
+	"FileVault 2 Status", "Full Name", "IP Address", "Last Check-in", "Last Inventory Update", "MAC Address",
+	"Managed", "Model", "Model Identifier", "Operating System", "Operating System Build", "Operating System Version",
+	"Phone Number", "Position", "Processor Type", "Room", "Serial Number", "Site", "Total RAM MB", "UDID", "Username",
+}
+
+// warnReservedName logs a warning when name matches a built-in inventory field, ignoring case. This is a soft
+// check: some tenants have long-standing attributes with such names, so the plan is never blocked.
+func warnReservedName(ctx context.Context, diff *schema.ResourceDiff) {
+	if !diff.NewValueKnown("name") {
+		return
+	}
+
+	name := strings.TrimSpace(diff.Get("name").(string))
+	for _, reserved := range reservedInventoryFieldNames {
+		if strings.EqualFold(name, reserved) {
+			tflog.Warn(ctx, fmt.Sprintf("Computer extension attribute '%s' has the same name as the built-in inventory field '%s'; "+
+				"reports, smart group criteria and advanced searches will show both under one name, so choose a distinct name", name, reserved))
+			return
+		}
+	}
+}
@@ -1,6 +1,22 @@
 package computerextensionattributes
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// diffSuppressScriptContents ignores line ending and trailing whitespace differences in script_contents,
+// as Jamf Pro may add or strip a final newline or trailing spaces when it stores a script.
+func diffSuppressScriptContents(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimRight(normalizeScript(old), " \t\n") == strings.TrimRight(normalizeScript(new), " \t\n")
+}
+
+// diffSuppressDescription ignores line ending and trailing whitespace differences in description, which
+// are removed before the description is sent to Jamf Pro.
+func diffSuppressDescription(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeDescription(old) == normalizeDescription(new)
+}
 
 // diffSuppressPopupMenuChoices is a custom diff suppression function for the popup_menu_choices attribute.
 // This attribute looks for matched values and ignores the order returned by the server.
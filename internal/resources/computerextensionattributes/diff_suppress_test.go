@@ -0,0 +1,59 @@
+package computerextensionattributes
+
+import "testing"
+
+const testScript = "#!/bin/zsh\necho \"<result>$(sw_vers -productVersion)</result>\""
+
+func TestNormalizeScript(t *testing.T) {
+	cases := []struct {
+		name   string
+		script string
+		want   string
+	}{
+		{name: "already normalized", script: testScript, want: testScript},
+		{name: "CRLF line endings", script: "#!/bin/zsh\r\necho \"<result>$(sw_vers -productVersion)</result>\"\r\n", want: testScript},
+		{name: "trailing newline", script: testScript + "\n", want: testScript},
+		{name: "several trailing newlines", script: testScript + "\n\n\n", want: testScript},
+		{name: "byte order mark", script: byteOrderMark + testScript, want: testScript},
+		{name: "byte order mark, CRLF and trailing newline", script: "\ufeff#!/bin/zsh\r\necho \"<result>$(sw_vers -productVersion)</result>\"\r\n", want: testScript},
+		{name: "lone carriage return is kept", script: "#!/bin/zsh\recho", want: "#!/bin/zsh\recho"},
+		{name: "leading blank line is kept", script: "\n" + testScript, want: "\n" + testScript},
+		{name: "empty", script: "", want: ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := normalizeScript(tc.script); got != tc.want {
+				t.Fatalf("normalizeScript(%q) = %q, want %q", tc.script, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDiffSuppressScriptContents(t *testing.T) {
+	cases := []struct {
+		name     string
+		old      string
+		new      string
+		suppress bool
+	}{
+		{name: "identical", old: testScript, new: testScript, suppress: true},
+		{name: "final newline added by Jamf Pro", old: testScript + "\n", new: testScript, suppress: true},
+		{name: "final newline stripped by Jamf Pro", old: testScript, new: testScript + "\n", suppress: true},
+		{name: "CRLF in config", old: testScript, new: "#!/bin/zsh\r\necho \"<result>$(sw_vers -productVersion)</result>\"\r\n", suppress: true},
+		{name: "trailing spaces", old: testScript + "  \t\n", new: testScript, suppress: true},
+		{name: "byte order mark in config", old: testScript, new: byteOrderMark + testScript + "\r\n", suppress: true},
+		{name: "changed command", old: testScript, new: "#!/bin/zsh\necho \"<result>$(sw_vers -buildVersion)</result>\""},
+		{name: "changed interpreter", old: testScript, new: "#!/bin/bash\necho \"<result>$(sw_vers -productVersion)</result>\""},
+		{name: "indentation change", old: "#!/bin/zsh\nif true; then\n  echo\nfi", new: "#!/bin/zsh\nif true; then\necho\nfi"},
+		{name: "script removed", old: testScript, new: ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := diffSuppressScriptContents("script_contents", tc.old, tc.new, nil); got != tc.suppress {
+				t.Fatalf("diffSuppressScriptContents(%q, %q) = %t, want %t", tc.old, tc.new, got, tc.suppress)
+			}
+		})
+	}
+}
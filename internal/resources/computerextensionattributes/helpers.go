@@ -1,10 +1,35 @@
 package computerextensionattributes
 
-import "strings"
+import (
+	"fmt"
+	"os"
+	"strings"
+)
 
-// normalizeScript normalizes a script by replacing all CRLF with LF and trimming trailing newlines
+// byteOrderMark is the UTF-8 byte order mark some Windows editors write at the start of a file. Before a
+// shebang it stops macOS from finding the script's interpreter.
+const byteOrderMark = "\ufeff"
+
+// normalizeScript normalizes a script by removing a leading byte order mark, replacing all CRLF with LF
+// and trimming trailing newlines
 func normalizeScript(script string) string {
-	normalized := strings.Replace(script, "\r\n", "\n", -1)
+	normalized := strings.Replace(strings.TrimPrefix(script, byteOrderMark), "\r\n", "\n", -1)
 
 	return strings.TrimRight(normalized, "\n")
 }
+
+// normalizeDescription converts Windows line endings and strips trailing whitespace from a description,
+// which is how it is sent to Jamf Pro.
+func normalizeDescription(description string) string {
+	return strings.TrimRight(strings.Replace(description, "\r\n", "\n", -1), " \t\n")
+}
+
+// readScriptFile reads and normalizes the script at the given path.
+func readScriptFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read script_file_path '%s': %v", path, err)
+	}
+
+	return normalizeScript(string(content)), nil
+}
@@ -0,0 +1,109 @@
+package computerextensionattributes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceJamfProComputerExtensionAttributesList provides every computer extension attribute in Jamf Pro,
+// optionally filtered by name, for use when bulk importing or selecting attributes by their settings.
+func DataSourceJamfProComputerExtensionAttributesList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceListRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regular expression used to filter the computer extension attributes by name. All attributes are returned when omitted.",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Every computer extension attribute in Jamf Pro whose name matches name_regex.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the computer extension attribute.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the computer extension attribute.",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the computer extension attribute is enabled.",
+						},
+						"data_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data type of the computer extension attribute: STRING, INTEGER or DATE.",
+						},
+						"input_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The input type of the computer extension attribute, such as SCRIPT or POPUP.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceListRead pages through every computer extension attribute and states those matching name_regex.
+func dataSourceListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	nameRegex := d.Get("name_regex").(string)
+	pattern, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid name_regex '%s': %v", nameRegex, err))
+	}
+
+	var response *jamfpro.ResponseComputerExtensionAttributesList
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		response, apiErr = meta.(*jamfpro.Client).GetComputerExtensionAttributes("")
+		if apiErr != nil {
+			return client.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list Jamf Pro Computer Extension Attributes after retries: %v", err))
+	}
+
+	items := make([]interface{}, 0, len(response.Results))
+	for _, attribute := range response.Results {
+		if !pattern.MatchString(attribute.Name) {
+			continue
+		}
+
+		items = append(items, map[string]interface{}{
+			"id":         attribute.ID,
+			"name":       attribute.Name,
+			"enabled":    attribute.Enabled != nil && *attribute.Enabled,
+			"data_type":  attribute.DataType,
+			"input_type": attribute.InputType,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("computer extension attributes/%s", nameRegex))
+	if err := d.Set("items", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
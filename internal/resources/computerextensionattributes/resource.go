@@ -2,12 +2,19 @@
 package computerextensionattributes
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// maxDescriptionLength is the longest description accepted at plan time. Longer descriptions risk being
+// truncated by Jamf Pro, which would show as a diff after every apply.
+const maxDescriptionLength = 255
+
 // resourceJamfProComputerExtensionAttributes defines the schema and CRUD operations (Create, Read, Update, Delete)
 // for managing Jamf Pro Computer Extension Attributes in Terraform.
 func ResourceJamfProComputerExtensionAttributes() *schema.Resource {
@@ -30,6 +37,7 @@ func ResourceJamfProComputerExtensionAttributes() *schema.Resource {
 				Version: 0,
 			},
 		},
+		CustomizeDiff: customDiffComputerExtensionAttributes,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -44,47 +52,75 @@ func ResourceJamfProComputerExtensionAttributes() *schema.Resource {
 				Required:    true,
 				Description: "The unique name of the Jamf Pro computer extension attribute.",
 			},
-			"description": {
-				Type:        schema.TypeString,
+			"allow_deletion": sharedschemas.GetSharedSchemaAllowDeletion(),
+			"adopt_existing": {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Description of the computer extension attribute.",
+				Default:     false,
+				Description: "When true and a computer extension attribute with the same name already exists in Jamf Pro, create takes that attribute into state and updates it to match the configuration instead of failing. Useful for pipelines that re-run partially applied configurations. Only consulted on create.",
+			},
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      fmt.Sprintf("Description of the computer extension attribute, up to %d characters. May span multiple lines; trailing whitespace is not sent to Jamf Pro.", maxDescriptionLength),
+				ValidateFunc:     validation.StringLenBetween(0, maxDescriptionLength),
+				DiffSuppressFunc: diffSuppressDescription,
 			},
 			"data_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      "String",
-				Description:  "Data type of the computer extension attribute. Can be String, Integer, or Date.",
+				Default:      "STRING",
+				ForceNew:     true,
+				Description:  "Data type of the computer extension attribute. Can be STRING, INTEGER, or DATE. Changing this replaces the extension attribute, which deletes every value already collected for it from computer inventory.",
 				ValidateFunc: validation.StringInSlice([]string{"STRING", "INTEGER", "DATE"}, false),
 			},
 			"enabled": {
 				Type:        schema.TypeBool,
 				Required:    true,
-				Description: "Enabled by default, but for inputType Script we can disable it as well.Possible values are: false or true.",
+				Description: "Whether Jamf Pro collects and displays the extension attribute. Disabling it in the Jamf Pro console is detected as drift and reverted on the next apply.",
 			},
 			"inventory_display_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      "Extension Attributes",
-				Description:  "Category in which to display the extension attribute in Jamf Pro.",
-				ValidateFunc: validation.StringInSlice([]string{"GENERAL", "HARDWARE", "OPERATING_SYSTEM", "USER_AND_LOCATION", "PURCHASING", "EXTENSION_ATTRIBUTES"}, false),
+				Default:      "EXTENSION_ATTRIBUTES",
+				Description:  fmt.Sprintf("Category in which to display the extension attribute in Jamf Pro. Can be %s.", strings.Join(allInventoryDisplayTypes, ", ")),
+				ValidateFunc: validation.StringInSlice(allInventoryDisplayTypes, false),
 			},
 			"input_type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Extension attributes collect inventory data by using an input type.The type of the Input used to populate the extension attribute.",
+				ForceNew:     true,
+				Description:  "Extension attributes collect inventory data by using an input type. The type of the Input used to populate the extension attribute. Changing this replaces the extension attribute and discards its collected inventory values.",
 				ValidateFunc: validation.StringInSlice([]string{"SCRIPT", "TEXT", "POPUP", "DIRECTORY_SERVICE_ATTRIBUTE_MAPPING"}, false),
 			},
 			"script_contents": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "When we run this script it returns a data value each time a computer submits inventory to Jamf Pro. Provide scriptContents only when inputType is 'SCRIPT'.",
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return normalizeScript(old) == normalizeScript(new)
-				},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"script_file_path"},
+				Description:      "When we run this script it returns a data value each time a computer submits inventory to Jamf Pro. Provide scriptContents only when inputType is 'SCRIPT'. When script_file_path is used this holds the contents read from the file. Windows (CRLF) line endings are converted to LF, so scripts rendered with templatefile on Windows do not show a diff.",
+				DiffSuppressFunc: diffSuppressScriptContents,
 				StateFunc: func(v interface{}) string {
 					return normalizeScript(v.(string))
 				},
 			},
+			"script_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the script contents of a SCRIPT input type extension attribute stored in Jamf Pro, as a hex string. Changes when the script is edited in Terraform or in Jamf Pro.",
+			},
+			"script_file_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"script_contents"},
+				Description:   "Path to a file containing the script, as an alternative to script_contents. Relative paths are resolved against the directory Terraform runs in, so use \"${path.module}/script.sh\" to refer to a file in the module directory.",
+			},
+			"script_size_warning_bytes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Size in bytes above which a SCRIPT extension attribute logs a warning at plan time, as very large scripts can fail to save in Jamf Pro. Move most of the logic into a script deployed by a policy and call it from the extension attribute instead. Defaults to 102400 (100 KiB); set to 0 to turn the warning off. Not sent to Jamf Pro.",
+			},
 			"popup_menu_choices": {
 				Type:        schema.TypeList,
 				Optional:    true,
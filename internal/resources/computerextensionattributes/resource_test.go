@@ -0,0 +1,93 @@
+package computerextensionattributes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestInventoryDisplayTypeValidation(t *testing.T) {
+	cases := []struct {
+		name        string
+		displayType string
+		inputType   string
+		wantErr     bool
+	}{
+		{name: "section accepted for script", displayType: "HARDWARE", inputType: "SCRIPT"},
+		{name: "section accepted for pop-up", displayType: "USER_AND_LOCATION", inputType: "POPUP"},
+		{name: "default section", displayType: "EXTENSION_ATTRIBUTES", inputType: "TEXT"},
+		{name: "classic API display name", displayType: "Extension Attributes", inputType: "TEXT", wantErr: true},
+		{name: "lower case section", displayType: "hardware", inputType: "SCRIPT", wantErr: true},
+		{name: "unknown section", displayType: "NETWORK", inputType: "DIRECTORY_SERVICE_ATTRIBUTE_MAPPING", wantErr: true},
+	}
+
+	resource := ResourceJamfProComputerExtensionAttributes()
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":                   "Test Attribute",
+				"enabled":                true,
+				"input_type":             tc.inputType,
+				"inventory_display_type": tc.displayType,
+			})
+
+			diags := resource.Validate(config)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("inventory_display_type %q with input_type %q: got errors %v, want error %t", tc.displayType, tc.inputType, diags, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestScriptContentsPlan(t *testing.T) {
+	const stored = "#!/bin/zsh\nif [ -f /Library/Example ]; then\n  echo \"<result>Yes</result>\"\nfi"
+
+	cases := []struct {
+		name       string
+		configured string
+		wantDiff   bool
+	}{
+		{name: "same script", configured: stored},
+		{name: "CRLF line endings", configured: strings.ReplaceAll(stored, "\n", "\r\n")},
+		{name: "CRLF with a final line break", configured: strings.ReplaceAll(stored, "\n", "\r\n") + "\r\n"},
+		{name: "final newline", configured: stored + "\n"},
+		{name: "trailing whitespace", configured: stored + "  \n\t\n"},
+		{name: "byte order mark from a Windows editor", configured: byteOrderMark + strings.ReplaceAll(stored, "\n", "\r\n")},
+		{name: "edited script", configured: strings.Replace(stored, "Yes", "No", 1), wantDiff: true},
+	}
+
+	resource := ResourceJamfProComputerExtensionAttributes()
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			attributes := map[string]interface{}{
+				"name":       "Example Present",
+				"enabled":    true,
+				"input_type": "SCRIPT",
+			}
+
+			// State as read back from Jamf Pro, which stores the script with LF line endings.
+			d := schema.TestResourceDataRaw(t, resource.Schema, attributes)
+			d.SetId("7")
+			if err := d.Set("script_contents", stored); err != nil {
+				t.Fatal(err)
+			}
+			state := d.State()
+
+			attributes["script_contents"] = tc.configured
+			diff, err := resource.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(attributes), nil)
+			if err != nil {
+				t.Fatalf("planning: %v", err)
+			}
+
+			_, planned := diff.GetAttribute("script_contents")
+			if planned != tc.wantDiff {
+				t.Fatalf("got script_contents diff %t, want %t: %v", planned, tc.wantDiff, diff)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package computerextensionattributes
 
 import (
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -13,7 +14,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceComputerExtension
 	if err := d.Set("id", resp.ID); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("name", resp.Name); err != nil {
+	if err := d.Set("name", common.DecodeName(resp.Name)); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("description", resp.Description); err != nil {
@@ -22,7 +23,8 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceComputerExtension
 	if err := d.Set("data_type", resp.DataType); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("enabled", resp.Enabled); err != nil {
+	// Always state the value Jamf Pro returns, so an attribute disabled in the console shows as drift.
+	if err := d.Set("enabled", resp.Enabled != nil && *resp.Enabled); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("inventory_display_type", resp.InventoryDisplayType); err != nil {
@@ -39,6 +41,9 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceComputerExtension
 		if err := d.Set("script_contents", normalizedScript); err != nil {
 			return diag.FromErr(err)
 		}
+		if err := d.Set("script_sha256", common.HashString(normalizedScript)); err != nil {
+			return diag.FromErr(err)
+		}
 	case "POPUP":
 		if err := d.Set("popup_menu_choices", resp.PopupMenuChoices); err != nil {
 			return diag.FromErr(err)
@@ -0,0 +1,121 @@
+package computerextensionattributes
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestReadDecodesHTMLEncodedName reads an extension attribute whose name Jamf Pro returns with HTML
+// entities and checks that the stored name matches the configuration, so the plan is clean.
+func TestReadDecodesHTMLEncodedName(t *testing.T) {
+	const name = "Sales & Marketing's Tag"
+
+	server := jamfmock.NewServer()
+	defer server.Close()
+	server.Handle(http.MethodGet, "/api/v1/computer-extension-attributes/7", jamfmock.JSON(http.StatusOK,
+		`{"id":"7","name":"Sales &amp; Marketing&#39;s Tag","enabled":true,"dataType":"STRING","inputType":"TEXT","inventoryDisplayType":"EXTENSION_ATTRIBUTES"}`))
+
+	client, err := server.Client()
+	if err != nil {
+		t.Fatalf("building mock client: %v", err)
+	}
+
+	resource := ResourceJamfProComputerExtensionAttributes()
+	config := map[string]interface{}{
+		"name":       name,
+		"enabled":    true,
+		"input_type": "TEXT",
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, config)
+	d.SetId("7")
+
+	if diags := readWithCleanup(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+
+	if got := d.Get("name").(string); got != name {
+		t.Fatalf("got name %q in state, want %q", got, name)
+	}
+
+	diff, err := resource.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("planning: %v", err)
+	}
+	if _, ok := diff.GetAttribute("name"); ok {
+		t.Fatalf("got a diff on name after reading the HTML-encoded name: %v", diff)
+	}
+}
+
+// TestReadSurfacesConsoleDisable reads an extension attribute whose enabled state was changed in Jamf Pro
+// and checks that the change is planned as drift, and that the update built from the plan restores the
+// configured value.
+func TestReadSurfacesConsoleDisable(t *testing.T) {
+	cases := []struct {
+		name        string
+		enabledJSON string
+		wantEnabled bool
+	}{
+		{name: "still enabled", enabledJSON: `,"enabled":true`, wantEnabled: true},
+		{name: "disabled in the console", enabledJSON: `,"enabled":false`},
+		{name: "enabled missing from the response", enabledJSON: ``},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := jamfmock.NewServer()
+			defer server.Close()
+			server.Handle(http.MethodGet, "/api/v1/computer-extension-attributes/7", jamfmock.JSON(http.StatusOK,
+				`{"id":"7","name":"Asset Tag"`+tc.enabledJSON+`,"dataType":"STRING","inputType":"TEXT","inventoryDisplayType":"EXTENSION_ATTRIBUTES"}`))
+
+			client, err := server.Client()
+			if err != nil {
+				t.Fatalf("building mock client: %v", err)
+			}
+
+			resource := ResourceJamfProComputerExtensionAttributes()
+			config := map[string]interface{}{
+				"name":       "Asset Tag",
+				"enabled":    true,
+				"input_type": "TEXT",
+			}
+
+			d := schema.TestResourceDataRaw(t, resource.Schema, config)
+			d.SetId("7")
+
+			if diags := readWithCleanup(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("read: %v", diags)
+			}
+
+			if got := d.Get("enabled").(bool); got != tc.wantEnabled {
+				t.Fatalf("got enabled %t in state, want %t", got, tc.wantEnabled)
+			}
+
+			state := d.State()
+			diff, err := resource.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			if err != nil {
+				t.Fatalf("planning: %v", err)
+			}
+			if _, planned := diff.GetAttribute("enabled"); planned == tc.wantEnabled {
+				t.Fatalf("got enabled diff %t, want %t: %v", planned, !tc.wantEnabled, diff)
+			}
+
+			planned, err := schema.InternalMap(resource.Schema).Data(state, diff)
+			if err != nil {
+				t.Fatalf("applying plan: %v", err)
+			}
+			payload, err := construct(context.Background(), planned)
+			if err != nil {
+				t.Fatalf("construct: %v", err)
+			}
+			if payload.Enabled == nil || !*payload.Enabled {
+				t.Fatalf("got enabled %v in the update payload, want true", payload.Enabled)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// computerinventory_computer_data_source.go
+package computerinventory
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// computerLookupSections are the inventory sections fetched when looking up a computer by serial number or name.
+const computerLookupSections = "&section=GENERAL&section=HARDWARE&section=OPERATING_SYSTEM&section=USER_AND_LOCATION"
+
+// DataSourceJamfProComputer looks up a single computer by its ID, serial number or name and provides its key
+// inventory fields. When several identifiers are set, the ID is used first, then the serial number, then the name.
+func DataSourceJamfProComputer() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceComputerRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "serial_number", "name"},
+				Description:  "The Jamf Pro ID of the computer.",
+			},
+			"serial_number": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "serial_number", "name"},
+				Description:  "The hardware serial number of the computer.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "serial_number", "name"},
+				Description:  "The name of the computer. Looking up by name fails if more than one computer has the name.",
+			},
+			"udid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UDID of the computer.",
+			},
+			"model": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hardware model of the computer.",
+			},
+			"last_contact_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the computer last checked in with Jamf Pro.",
+			},
+			"report_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the computer last submitted inventory.",
+			},
+			"os_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating system version of the computer.",
+			},
+			"os_build": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating system build of the computer.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The username of the user assigned to the computer.",
+			},
+			"real_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full name of the user assigned to the computer.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of the user assigned to the computer.",
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the site the computer belongs to.",
+			},
+		},
+	}
+}
+
+// dataSourceComputerRead resolves the configured identifier to a single computer and states its key inventory fields.
+func dataSourceComputerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok {
+		return diag.Errorf("error asserting meta as *client.client")
+	}
+
+	var diags diag.Diagnostics
+	var computer *jamfpro.ResourceComputerInventory
+	var description string
+
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		if id, ok := d.GetOk("id"); ok {
+			description = fmt.Sprintf("with ID '%s'", id.(string))
+			computer, apiErr = client.GetComputerInventoryByID(id.(string))
+		} else if serial, ok := d.GetOk("serial_number"); ok {
+			description = fmt.Sprintf("with serial number '%s'", serial.(string))
+			computer, apiErr = findComputer(client, "hardware.serialNumber", serial.(string))
+		} else {
+			name := d.Get("name").(string)
+			description = fmt.Sprintf("named '%s'", name)
+			computer, apiErr = findComputer(client, "general.name", name)
+		}
+
+		if apiErr != nil {
+			return jamfclient.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to look up Jamf Pro computer %s after retries: %v", description, err))
+	}
+
+	if computer == nil {
+		return diag.Errorf("no Jamf Pro computer found %s", description)
+	}
+
+	d.SetId(computer.ID)
+
+	attributes := map[string]interface{}{
+		"id":                computer.ID,
+		"serial_number":     computer.Hardware.SerialNumber,
+		"name":              computer.General.Name,
+		"udid":              computer.UDID,
+		"model":             computer.Hardware.Model,
+		"last_contact_time": computer.General.LastContactTime,
+		"report_date":       computer.General.ReportDate,
+		"os_version":        computer.OperatingSystem.Version,
+		"os_build":          computer.OperatingSystem.Build,
+		"username":          computer.UserAndLocation.Username,
+		"real_name":         computer.UserAndLocation.Realname,
+		"email":             computer.UserAndLocation.Email,
+		"site_id":           computer.General.Site.ID,
+	}
+
+	for key, value := range attributes {
+		if err := d.Set(key, value); err != nil {
+			diags = append(diags, diag.FromErr(fmt.Errorf("error setting '%s' for Jamf Pro computer with ID '%s': %v", key, computer.ID, err))...)
+		}
+	}
+
+	return diags
+}
+
+// findComputer returns the single computer whose inventory field equals value, nil if there is none,
+// or an error if several computers match.
+func findComputer(client *jamfpro.Client, field string, value string) (*jamfpro.ResourceComputerInventory, error) {
+	filter := fmt.Sprintf(`%s=="%s"`, field, strings.ReplaceAll(value, `"`, `\"`))
+
+	response, err := client.GetComputersInventory(computerLookupSections + "&filter=" + url.QueryEscape(filter))
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(response.Results) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &response.Results[0], nil
+	default:
+		ids := make([]string, 0, len(response.Results))
+		for _, computer := range response.Results {
+			ids = append(ids, computer.ID)
+		}
+		return nil, fmt.Errorf("%d computers match %s (IDs: %s)", len(ids), filter, strings.Join(ids, ", "))
+	}
+}
@@ -11,12 +11,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// singletonID is the fixed ID of the one computer inventory collection configuration in Jamf Pro.
+const singletonID = "jamfpro_computer_inventory_collection_singleton"
+
 // create is responsible for initializing the Jamf Pro Computer Inventory Collection configuration in Terraform.
 func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	resource, err := construct(d)
+	resource, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Computer Inventory Collection for update: %v", err))
 	}
@@ -33,7 +36,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		return diag.FromErr(fmt.Errorf("failed to apply Jamf Pro Computer Inventory Collection configuration after retries: %v", err))
 	}
 
-	d.SetId("jamfpro_computer_inventory_collection_singleton")
+	d.SetId(singletonID)
 
 	return append(diags, readNoCleanup(ctx, d, meta)...)
 }
@@ -44,7 +47,7 @@ func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup
 	var diags diag.Diagnostics
 	var err error
 
-	d.SetId("jamfpro_computer_inventory_collection_singleton")
+	d.SetId(singletonID)
 	var response *jamfpro.ResourceComputerInventoryCollection
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
@@ -77,12 +80,12 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	inventoryCollectionConfig, err := construct(d)
+	inventoryCollectionConfig, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Computer Inventory Collection for update: %v", err))
 	}
 
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		apiErr := client.UpdateComputerInventoryCollectionInformation(inventoryCollectionConfig)
 		if apiErr != nil {
 			return retry.RetryableError(apiErr)
@@ -94,7 +97,7 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 		return diag.FromErr(fmt.Errorf("failed to apply Jamf Pro Computer Inventory Collection configuration after retries: %v", err))
 	}
 
-	d.SetId("jamfpro_computer_checkin_singleton")
+	d.SetId(singletonID)
 
 	return append(diags, readNoCleanup(ctx, d, meta)...)
 }
@@ -103,7 +106,23 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 // Since this resource represents a configuration and not an actual entity that can be deleted,
 // this function will simply remove it from the Terraform state.
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Computer inventory collection settings removed from state only",
+		Detail:   "The inventory categories and search paths configured in Jamf Pro are left as they are. Jamf Pro keeps collecting inventory with them until they are changed in Jamf Pro or managed by Terraform again.",
+	})
+
 	d.SetId("")
 
-	return nil
+	return diags
+}
+
+// importState adopts the computer inventory collection configuration whatever ID is given on import,
+// as Jamf Pro has a single set of these settings.
+func importState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(singletonID)
+
+	return []*schema.ResourceData{d}, nil
 }
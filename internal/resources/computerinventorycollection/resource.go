@@ -7,7 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// resourceJamfProComputerInventoryCollection defines the schema and RU operations for managing Jamf Pro computer checkin configuration in Terraform.
+// ResourceJamfProComputerInventoryCollection defines the schema and RU operations for managing the Jamf Pro computer inventory collection settings in Terraform.
 func ResourceJamfProComputerInventoryCollection() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: create,
@@ -21,7 +21,7 @@ func ResourceJamfProComputerInventoryCollection() *schema.Resource {
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importState,
 		},
 		Schema: map[string]*schema.Schema{
 			"local_user_accounts": {
@@ -93,7 +93,7 @@ func ResourceJamfProComputerInventoryCollection() *schema.Resource {
 						"path": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "Path to the application.",
+							Description: "Custom search path for applications, such as /Users/Shared/Applications.",
 						},
 						"platform": {
 							Type:        schema.TypeString,
@@ -112,7 +112,7 @@ func ResourceJamfProComputerInventoryCollection() *schema.Resource {
 						"path": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "Path to the font.",
+							Description: "Custom search path for fonts.",
 						},
 						"platform": {
 							Type:        schema.TypeString,
@@ -131,7 +131,7 @@ func ResourceJamfProComputerInventoryCollection() *schema.Resource {
 						"path": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "Path to the plugin.",
+							Description: "Custom search path for plugins.",
 						},
 						"platform": {
 							Type:        schema.TypeString,
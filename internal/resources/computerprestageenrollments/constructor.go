@@ -2,15 +2,17 @@
 package computerprestageenrollments
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func construct(d *schema.ResourceData, isUpdate bool) (*jamfpro.ResourceComputerPrestage, error) {
+func construct(ctx context.Context, d *schema.ResourceData, isUpdate bool) (*jamfpro.ResourceComputerPrestage, error) {
 	versionLock := handleVersionLock(d.Get("version_lock"), isUpdate)
 
 	resource := &jamfpro.ResourceComputerPrestage{
@@ -128,7 +130,7 @@ func construct(d *schema.ResourceData, isUpdate bool) (*jamfpro.ResourceComputer
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Computer Prestage to JSON: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Computer Computer Prestage JSON:\n%s\n", string(resourceJSON))
+	common.LogConstructedPayload(ctx, "Jamf Pro Computer Computer Prestage JSON", string(resourceJSON))
 
 	return resource, nil
 }
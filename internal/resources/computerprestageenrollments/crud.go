@@ -17,7 +17,7 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	var diags diag.Diagnostics
 	isUpdate := false
 
-	resource, err := construct(d, isUpdate)
+	resource, err := construct(ctx, d, isUpdate)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Computer Prestage Enrollment: %v", err))
 	}
@@ -63,31 +63,28 @@ func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}
 	return read(ctx, d, meta, false)
 }
 
-// update is responsible for updating an existing Jamf Pro Department on the remote system.
+// update is responsible for updating an existing Jamf Pro Computer Prestage Enrollment on the remote system.
+// A stale version lock is refreshed and the update retried once.
 func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
-	var diags diag.Diagnostics
-	resourceID := d.Id()
-	isUpdate := true
-
-	resource, err := construct(d, isUpdate)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Computer Prestage Enrollment for update: %v", err))
-	}
-
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		_, apiErr := client.UpdateComputerPrestageByID(resourceID, resource)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
-		return nil
-	})
 
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to update Jamf Pro Computer Prestage Enrollment '%s' (ID: %s) after retries: %v", resource.DisplayName, resourceID, err))
-	}
-
-	return append(diags, readNoCleanup(ctx, d, meta)...)
+	return common.UpdateWithVersionLock(
+		ctx,
+		d,
+		meta,
+		func(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceComputerPrestage, error) {
+			return construct(ctx, d, true)
+		},
+		client.UpdateComputerPrestageByID,
+		readNoCleanup,
+		func(resourceID string) (int, error) {
+			current, err := client.GetComputerPrestageByID(resourceID)
+			if err != nil {
+				return 0, err
+			}
+			return current.VersionLock, nil
+		},
+	)
 }
 
 // delete is responsible for deleting a Jamf Pro Computer Prestage.
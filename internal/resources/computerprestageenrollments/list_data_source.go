@@ -0,0 +1,26 @@
+package computerprestageenrollments
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProComputerPrestageEnrollmentsList provides the ID and name of every computer prestage enrollment in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProComputerPrestageEnrollmentsList() *schema.Resource {
+	return common.ListDataSource("computer prestage enrollment", listNames)
+}
+
+// listNames returns the ID and name of every computer prestage enrollment in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetComputerPrestages("")
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Results))
+	for _, item := range response.Results {
+		objects = append(objects, common.NamedObject{ID: item.ID, Name: item.DisplayName})
+	}
+	return objects, nil
+}
@@ -104,7 +104,7 @@ func ResourceJamfProComputerPrestageEnrollmentEnrollment() *schema.Resource {
 			"device_enrollment_program_instance_id": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The Automated Device Enrollment instance ID to associate with the PreStage enrollment. Devices associated with the selected Automated Device Enrollment instance can be assigned the PreStage enrollment",
+				Description: "The Automated Device Enrollment instance ID to associate with the PreStage enrollment. Devices associated with the selected Automated Device Enrollment instance can be assigned the PreStage enrollment. Look the ID up by name with the jamfpro_device_enrollment data source.",
 			},
 			"skip_setup_items": {
 				Type:        schema.TypeList,
@@ -491,7 +491,7 @@ func ResourceJamfProComputerPrestageEnrollmentEnrollment() *schema.Resource {
 			"profile_uuid": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The profile UUID of the Automated Device Enrollment instance to associate with the PreStage enrollment. Devices associated with the selected Automated Device Enrollment instance can be assigned the PreStage enrollment",
+				Description: "The profile UUID of the Automated Device Enrollment instance to associate with the PreStage enrollment. Devices associated with the selected Automated Device Enrollment instance can be assigned the PreStage enrollment. Look the ID up by name with the jamfpro_device_enrollment data source.",
 			},
 			"site_id": {
 				Type:        schema.TypeString,
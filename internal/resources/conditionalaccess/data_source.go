@@ -0,0 +1,63 @@
+package conditionalaccess
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProConditionalAccess provides the device compliance feature state of the Jamf Pro
+// conditional access integration, so configurations can depend on it being switched on.
+func DataSourceJamfProConditionalAccess() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A fixed identifier for the conditional access configuration.",
+			},
+			"shared_device_feature_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether device compliance reporting for shared devices is switched on for the conditional access integration.",
+			},
+		},
+	}
+}
+
+// dataSourceRead fetches the device compliance feature state from Jamf Pro.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	jamfClient := meta.(*jamfpro.Client)
+
+	var status *jamfpro.ResourceConditionalAccessDeviceComplianceStatus
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		status, apiErr = jamfClient.GetConditionalAccessDeviceComplianceFeatureEnablement()
+		if apiErr != nil {
+			return client.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Conditional Access device compliance after retries: %v", err))
+	}
+
+	d.SetId("jamfpro_conditional_access_singleton")
+
+	if err := d.Set("shared_device_feature_enabled", status.SharedDeviceFeatureEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -2,16 +2,17 @@
 package departments
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProDepartment constructs a Jamf Pro Department struct from Terraform resource data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceDepartment, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceDepartment, error) {
 	resource := &jamfpro.ResourceDepartment{
 		Name: d.Get("name").(string),
 	}
@@ -21,7 +22,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceDepartment, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Department '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Department XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Department XML", string(resourceXML))
 
 	return resource, nil
 }
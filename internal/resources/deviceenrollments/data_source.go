@@ -0,0 +1,152 @@
+// deviceenrollments_data_source.go
+package deviceenrollments
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProDeviceEnrollment provides an Automated Device Enrollment instance, looked up by ID or name,
+// so prestage enrollments can reference it and its server token expiry can be monitored.
+func DataSourceJamfProDeviceEnrollment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The unique identifier of the Automated Device Enrollment instance.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the Automated Device Enrollment instance.",
+			},
+			"site_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the site the instance belongs to, or -1 for none.",
+			},
+			"supervision_identity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the supervision identity used for devices enrolled through the instance.",
+			},
+			"server_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the MDM server in Apple Business Manager or Apple School Manager.",
+			},
+			"server_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the MDM server in Apple Business Manager or Apple School Manager.",
+			},
+			"admin_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Apple ID of the administrator who downloaded the server token.",
+			},
+			"org_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The organisation name registered with Apple.",
+			},
+			"org_email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The organisation email address registered with Apple.",
+			},
+			"org_phone": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The organisation phone number registered with Apple.",
+			},
+			"org_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The organisation address registered with Apple.",
+			},
+			"token_expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the server token expires and must be renewed in Apple Business Manager or Apple School Manager.",
+			},
+		},
+	}
+}
+
+// dataSourceRead lists the Automated Device Enrollment instances and states the one matching id or name.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	jamfClient := meta.(*jamfpro.Client)
+	var diags diag.Diagnostics
+
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+
+	var response *jamfpro.ResponseDeviceEnrollmentsList
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		response, apiErr = jamfClient.GetDeviceEnrollments("")
+		if apiErr != nil {
+			return client.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to list Jamf Pro Automated Device Enrollment instances after retries: %v", err))
+	}
+
+	var match *jamfpro.ResourceDeviceEnrollment
+	for i, instance := range response.Results {
+		if (id != "" && instance.ID == id) || (id == "" && instance.Name == name) {
+			match = &response.Results[i]
+			break
+		}
+	}
+
+	if match == nil {
+		if id != "" {
+			return diag.FromErr(fmt.Errorf("no Jamf Pro Automated Device Enrollment instance found with ID '%s'", id))
+		}
+		return diag.FromErr(fmt.Errorf("no Jamf Pro Automated Device Enrollment instance found with name '%s'", name))
+	}
+
+	d.SetId(match.ID)
+
+	resourceData := map[string]interface{}{
+		"name":                    match.Name,
+		"site_id":                 match.SiteId,
+		"supervision_identity_id": match.SupervisionIdentityId,
+		"server_name":             match.ServerName,
+		"server_uuid":             match.ServerUuid,
+		"admin_id":                match.AdminId,
+		"org_name":                match.OrgName,
+		"org_email":               match.OrgEmail,
+		"org_phone":               match.OrgPhone,
+		"org_address":             match.OrgAddress,
+		"token_expiration_date":   match.TokenExpirationDate,
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -2,6 +2,7 @@
 package diskencryptionconfigurations
 
 import (
+	"context"
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
@@ -10,7 +11,7 @@ import (
 )
 
 // constructJamfProDiskEncryptionConfiguration constructs a ResourceDiskEncryptionConfiguration object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceDiskEncryptionConfiguration, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceDiskEncryptionConfiguration, error) {
 	resource := &jamfpro.ResourceDiskEncryptionConfiguration{
 		Name:                  d.Get("name").(string),
 		KeyType:               d.Get("key_type").(string),
@@ -32,7 +33,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceDiskEncryptionConfigura
 		log.Fatalf("Error: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Disk Encryption Configurations XML:\n%s\n", string(xmlOutput))
+	common.LogConstructedPayload(ctx, "Jamf Pro Disk Encryption Configurations XML", string(xmlOutput))
 
 	return resource, nil
 }
@@ -0,0 +1,28 @@
+package diskencryptionconfigurations
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProDiskEncryptionConfigurationsList provides the ID and name of every disk encryption configuration in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProDiskEncryptionConfigurationsList() *schema.Resource {
+	return common.ListDataSource("disk encryption configuration", listNames)
+}
+
+// listNames returns the ID and name of every disk encryption configuration in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetDiskEncryptionConfigurations()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.DiskEncryptionConfiguration))
+	for _, item := range response.DiskEncryptionConfiguration {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
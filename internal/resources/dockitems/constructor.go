@@ -2,16 +2,17 @@
 package dockitems
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProDockItem constructs a ResourceDockItem object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceDockItem, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceDockItem, error) {
 	resource := &jamfpro.ResourceDockItem{
 		Name:     d.Get("name").(string),
 		Type:     d.Get("type").(string),
@@ -24,7 +25,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceDockItem, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Dock Item '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Dock Item XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Dock Item XML", string(resourceXML))
 
 	return resource, nil
 }
@@ -0,0 +1,28 @@
+package dockitems
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProDockItemsList provides the ID and name of every dock item in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProDockItemsList() *schema.Resource {
+	return common.ListDataSource("dock item", listNames)
+}
+
+// listNames returns the ID and name of every dock item in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetDockItems()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.DockItems))
+	for _, item := range response.DockItems {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -2,6 +2,7 @@
 package filesharedistributionpoints
 
 import (
+	"context"
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
@@ -10,7 +11,7 @@ import (
 )
 
 // cconstructJamfProFileShareDistributionPoint constructs a ResourceDockItem object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceFileShareDistributionPoint, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceFileShareDistributionPoint, error) {
 	resource := &jamfpro.ResourceFileShareDistributionPoint{
 		Name:                     d.Get("name").(string),
 		IP_Address:               d.Get("ip_address").(string),
@@ -41,7 +42,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceFileShareDistributionPo
 		log.Fatalf("Error: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro File Share Distribution Point XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro File Share Distribution Point XML", string(resourceXML))
 
 	return resource, nil
 }
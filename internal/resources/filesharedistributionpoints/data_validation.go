@@ -1,76 +1,87 @@
-// filesharedistributionpoints_data_source.go
 package filesharedistributionpoints
 
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// uriDistributionPoints is the classic API list endpoint. The SDK list response only decodes a single
+// distribution point, so the list is read into distributionPointsList instead.
+const uriDistributionPoints = "/JSSResource/distributionpoints"
+
+// distributionPointsList captures every entry of the classic API distribution point list.
+type distributionPointsList struct {
+	DistributionPoints []jamfpro.DistributionPointListItem `xml:"distribution_point"`
+}
+
 // mainCustomDiffFunc orchestrates all custom diff validations.
 func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
-	// Validate based on 'is_smart' attribute.
-	if err := validateIsSmartAttribute(ctx, diff, i); err != nil {
+	if err := validateSingleMaster(ctx, diff, i); err != nil {
 		return err
 	}
 
-	// Validate the sequential order of 'priority' in 'criteria' blocks.
-	if err := validateCriteriaPrioritySequence(ctx, diff, i); err != nil {
-		return err
-	}
-
-	// Add more validation calls here as needed.
-
 	return nil
 }
 
-// validateIsSmartAttribute checks the conditions related to the 'is_smart' attribute.
-func validateIsSmartAttribute(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
-	resourceName := diff.Get("name").(string)
-	isSmart, ok := diff.GetOkExists("is_smart")
-
-	if !ok {
+// validateSingleMaster ensures exactly one file share distribution point is marked 'is_master'. It
+// rejects marking this distribution point as master while another one already is, and un-marking it
+// while it is the only master. Distribution points in the same plan are not visible to each other,
+// so the check is made against the distribution points already in Jamf Pro.
+func validateSingleMaster(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*jamfpro.Client)
+	if !ok || client == nil || !diff.NewValueKnown("is_master") || !diff.HasChange("is_master") {
 		return nil
 	}
 
-	usersBlockExists := len(diff.Get("users").([]interface{})) > 0
-	criteriaBlockExists := len(diff.Get("criteria").([]interface{})) > 0
-
-	if isSmart.(bool) && usersBlockExists {
-		return fmt.Errorf("in 'jamfpro_user_group.%s': 'users' block is not allowed when 'is_smart' is set to true", resourceName)
-	}
+	resourceName := diff.Get("name").(string)
+	isMaster := diff.Get("is_master").(bool)
 
-	if !isSmart.(bool) && criteriaBlockExists {
-		return fmt.Errorf("in 'jamfpro_user_group.%s': 'criteria' block is not allowed when 'is_smart' is set to false", resourceName)
+	otherMaster, err := findOtherMaster(client, diff.Id())
+	if err != nil {
+		return fmt.Errorf("in 'jamfpro_file_share_distribution_point.%s': failed to check existing master distribution point: %v", resourceName, err)
 	}
 
-	if isSmart.(bool) && !criteriaBlockExists {
-		return fmt.Errorf("in 'jamfpro_user_group.%s': 'criteria' block is required when 'is_smart' is set to true", resourceName)
+	if isMaster && otherMaster != nil {
+		return fmt.Errorf("in 'jamfpro_file_share_distribution_point.%s': 'is_master' cannot be true as '%s' (ID: %d) is already the master distribution point", resourceName, otherMaster.Name, otherMaster.ID)
 	}
 
-	if !isSmart.(bool) && !usersBlockExists {
-		return fmt.Errorf("in 'jamfpro_user_group.%s': 'users' block is required when 'is_smart' is set to false", resourceName)
+	if !isMaster && diff.Id() != "" && otherMaster == nil {
+		return fmt.Errorf("in 'jamfpro_file_share_distribution_point.%s': 'is_master' cannot be set to false as this is the only master distribution point; mark another distribution point as master first", resourceName)
 	}
 
 	return nil
 }
 
-// validateCriteriaPrioritySequence checks that the 'priority' fields in the 'criteria' blocks are sequential starting from 0.
-func validateCriteriaPrioritySequence(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
-	resourceName := diff.Get("name").(string) // Assuming 'name' is always set and is unique
+// findOtherMaster returns the master distribution point if it is not the one with excludeID.
+func findOtherMaster(client *jamfpro.Client, excludeID string) (*jamfpro.ResourceFileShareDistributionPoint, error) {
+	var list distributionPointsList
+	resp, err := client.HTTP.DoRequest("GET", uriDistributionPoints, nil, &list)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	if criteriaBlocks, ok := diff.GetOk("criteria"); ok {
-		criteriaList := criteriaBlocks.([]interface{})
+	for _, item := range list.DistributionPoints {
+		id := strconv.Itoa(item.ID)
+		if id == excludeID {
+			continue
+		}
 
-		for expectedPriority, criteria := range criteriaList {
-			criteriaMap := criteria.(map[string]interface{})
+		distributionPoint, err := client.GetDistributionPointByID(id)
+		if err != nil {
+			return nil, err
+		}
 
-			if actualPriority, ok := criteriaMap["priority"].(int); !ok || actualPriority != expectedPriority {
-				return fmt.Errorf("in 'jamfpro_user_group.%s': 'priority' value in 'criteria' block must be sequential starting from 0, found priority '%d' at position '%d'", resourceName, actualPriority, expectedPriority)
-			}
+		if distributionPoint.IsMaster {
+			return distributionPoint, nil
 		}
 	}
 
-	return nil
+	return nil, nil
 }
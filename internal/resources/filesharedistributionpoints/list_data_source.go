@@ -0,0 +1,32 @@
+package filesharedistributionpoints
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProFileShareDistributionPointsList provides the ID and name of every file share distribution point in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProFileShareDistributionPointsList() *schema.Resource {
+	return common.ListDataSource("file share distribution point", listNames)
+}
+
+// listNames returns the ID and name of every file share distribution point in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	var list distributionPointsList
+	resp, err := client.HTTP.DoRequest("GET", uriDistributionPoints, nil, &list)
+	if resp != nil && resp.Body != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(list.DistributionPoints))
+	for _, item := range list.DistributionPoints {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
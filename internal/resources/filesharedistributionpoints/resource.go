@@ -44,7 +44,7 @@ func ResourceJamfProFileShareDistributionPoints() *schema.Resource {
 			"is_master": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Indicates if the distribution point is the principal distribution point, used  as the authoritative source for all files",
+				Description: "Indicates if the distribution point is the principal distribution point, used as the authoritative source for all files. Exactly one distribution point must be the master.",
 			},
 			"failover_point": {
 				Type:        schema.TypeString,
@@ -108,7 +108,8 @@ func ResourceJamfProFileShareDistributionPoints() *schema.Resource {
 			"password": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The password for the distribution point. This field is marked as sensitive and will not be displayed in logs or console output.",
+				Sensitive:   true,
+				Description: "The password for the distribution point. Write-only: Jamf Pro does not return it, so it is never read back into state.",
 			},
 
 			"workgroup_or_domain": {
@@ -125,7 +126,7 @@ func ResourceJamfProFileShareDistributionPoints() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Sensitive:   true,
-				Description: "The password for read-only access. This field is marked as sensitive.",
+				Description: "The password for read-only access. Write-only: Jamf Pro does not return it, so changes made outside Terraform are not detected.",
 			},
 			"read_write_username": {
 				Type:        schema.TypeString,
@@ -136,7 +137,7 @@ func ResourceJamfProFileShareDistributionPoints() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Sensitive:   true,
-				Description: "The password for read-write access. This field is marked as sensitive.",
+				Description: "The password for read-write access. Write-only: Jamf Pro does not return it, so changes made outside Terraform are not detected.",
 			},
 			"no_authentication_required": {
 				Type:        schema.TypeBool,
@@ -173,7 +174,7 @@ func ResourceJamfProFileShareDistributionPoints() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Sensitive:   true,
-				Description: "The password for HTTP access, if username/password authentication is required. This field is marked as sensitive.",
+				Description: "The password for HTTP access, if username/password authentication is required. Write-only: Jamf Pro does not return it, so changes made outside Terraform are not detected.",
 			},
 			"protocol": {
 				Type:        schema.TypeString,
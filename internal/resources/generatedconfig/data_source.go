@@ -0,0 +1,156 @@
+// generatedconfig/data_source.go
+// This package contains a data source that writes the HCL and import block for an object already in Jamf Pro.
+
+package generatedconfig
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceJamfProGeneratedConfig reads an existing Jamf Pro object with the import and read functions of the
+// given resources and returns a matching resource block and import block. Every resource with an importer can
+// be generated, so the output stays in step with the resource schemas as they change.
+func DataSourceJamfProGeneratedConfig(resources map[string]*schema.Resource) *schema.Resource {
+	resourceTypes := make([]string, 0, len(resources))
+	for resourceType, resource := range resources {
+		if resource.Importer != nil {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	sort.Strings(resourceTypes)
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return read(ctx, d, meta, resources)
+		},
+		Description: "Generates the resource and import blocks for an object that exists in Jamf Pro but is not yet managed by Terraform.",
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceTypes, false),
+				Description:  "The resource to generate, such as jamfpro_computer_extension_attribute. Any resource that supports import can be generated.",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the object in Jamf Pro, as accepted by terraform import for resource_type.",
+			},
+			"resource_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`), "must be a valid Terraform resource name"),
+				Description:  "The name of the generated resource block. Defaults to the object's name, converted to a valid identifier.",
+			},
+			"resource_hcl": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The generated resource block.",
+			},
+			"import_hcl": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The import block that adopts the object into the generated resource. Requires Terraform 1.5 or later.",
+			},
+			"hcl": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "import_hcl followed by resource_hcl, ready to be written to a .tf file.",
+			},
+		},
+	}
+}
+
+// read imports and reads the object into a blank resource and states the HCL generated from it.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}, resources map[string]*schema.Resource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceType := d.Get("resource_type").(string)
+	resourceID := d.Get("resource_id").(string)
+
+	resource, ok := resources[resourceType]
+	if !ok || resource.Importer == nil {
+		return diag.Errorf("resource type '%s' cannot be generated", resourceType)
+	}
+
+	target := resource.Data(nil)
+	target.SetId(resourceID)
+
+	if resource.Importer.StateContext != nil {
+		imported, err := resource.Importer.StateContext(ctx, target, meta)
+		if err != nil {
+			return diag.Errorf("failed to import %s '%s': %v", resourceType, resourceID, err)
+		}
+		if len(imported) > 0 {
+			target = imported[0]
+		}
+	}
+
+	readFunc := resource.ReadContext
+	if readFunc == nil {
+		readFunc = resource.ReadWithoutTimeout
+	}
+
+	diags = append(diags, readFunc(ctx, target, meta)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if target.Id() == "" {
+		return append(diags, diag.Errorf("%s '%s' was not found in Jamf Pro", resourceType, resourceID)...)
+	}
+
+	resourceName := d.Get("resource_name").(string)
+	if resourceName == "" {
+		resourceName = defaultResourceName(resourceType, resource, target)
+	}
+
+	resourceHCL := renderResource(resourceType, resourceName, resource, target)
+	importHCL := renderImport(resourceType, resourceName, target.Id())
+
+	d.SetId(fmt.Sprintf("%s/%s", resourceType, resourceID))
+
+	generated := map[string]interface{}{
+		"resource_hcl": resourceHCL,
+		"import_hcl":   importHCL,
+		"hcl":          importHCL + "\n" + resourceHCL,
+	}
+
+	for key, val := range generated {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// invalidNameCharacters matches runs of characters that cannot appear in a Terraform resource name.
+var invalidNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// defaultResourceName converts the object's name to a resource name, such as "Battery Cycle Count" to
+// battery_cycle_count. The resource type is prepended when the name is empty or starts with a digit.
+func defaultResourceName(resourceType string, resource *schema.Resource, target *schema.ResourceData) string {
+	name := ""
+	if _, ok := resource.SchemaMap()["name"]; ok {
+		name, _ = target.Get("name").(string)
+	}
+	if strings.TrimSpace(name) == "" {
+		name = target.Id()
+	}
+
+	name = strings.Trim(invalidNameCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = strings.TrimSuffix(strings.TrimPrefix(resourceType, "jamfpro_")+"_"+name, "_")
+	}
+
+	return name
+}
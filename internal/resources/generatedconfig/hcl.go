@@ -0,0 +1,216 @@
+package generatedconfig
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// renderImport returns an import block adopting the object with the given ID into the generated resource.
+func renderImport(resourceType string, resourceName string, id string) string {
+	file := hclwrite.NewEmptyFile()
+	block := file.Body().AppendNewBlock("import", nil)
+	block.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: resourceName},
+	})
+	block.Body().SetAttributeValue("id", cty.StringVal(id))
+
+	return string(hclwrite.Format(file.Bytes()))
+}
+
+// renderResource returns a resource block with the configurable arguments of the read object. Arguments the
+// read did not state, such as provider-side settings like allow_deletion, are left to their defaults.
+func renderResource(resourceType string, resourceName string, resource *schema.Resource, target *schema.ResourceData) string {
+	file := hclwrite.NewEmptyFile()
+	block := file.Body().AppendNewBlock("resource", []string{resourceType, resourceName})
+	writeBody(block.Body(), resource.SchemaMap(), true, func(key string) interface{} {
+		//nolint:staticcheck // GetOkExists is the only way to tell a stated zero value from an unstated one.
+		value, exists := target.GetOkExists(key)
+		if !exists {
+			return nil
+		}
+		return value
+	})
+
+	return string(hclwrite.Format(file.Bytes()))
+}
+
+// writeBody writes an argument or nested block for each configurable field of schemaMap, using get to look up
+// the field's value. Computed-only, deprecated and unset fields are left out, as are optional fields whose
+// value is their default, so the output only contains what differs from a new resource. The resource ID is
+// left out of the top level body, where it is the import ID rather than an argument.
+func writeBody(body *hclwrite.Body, schemaMap map[string]*schema.Schema, topLevel bool, get func(key string) interface{}) {
+	for _, key := range sortedKeys(schemaMap) {
+		s := schemaMap[key]
+		if (topLevel && key == "id") || (s.Computed && !s.Optional && !s.Required) || s.Deprecated != "" {
+			continue
+		}
+
+		value := get(key)
+
+		if s.Sensitive {
+			if s.Required || !isDefault(s, value) {
+				body.AppendUnstructuredTokens(commentTokens(fmt.Sprintf("# %s is sensitive and must be set before applying", key)))
+			}
+			continue
+		}
+
+		if !s.Required && isDefault(s, value) {
+			continue
+		}
+
+		if elem, ok := s.Elem.(*schema.Resource); ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			for _, item := range listValue(value) {
+				itemMap, _ := item.(map[string]interface{})
+				nested := body.AppendNewBlock(key, nil)
+				writeBody(nested.Body(), elem.SchemaMap(), false, func(key string) interface{} {
+					return itemMap[key]
+				})
+			}
+			continue
+		}
+
+		if tokens, ok := valueTokens(s, value); ok {
+			body.SetAttributeRaw(key, tokens)
+		}
+	}
+}
+
+// sortedKeys returns the keys of schemaMap in alphabetical order, with name first as it identifies the object.
+func sortedKeys(schemaMap map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(schemaMap))
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if (keys[i] == "name") != (keys[j] == "name") {
+			return keys[i] == "name"
+		}
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+// isDefault reports whether value is the schema default, or the zero value when the schema has no default.
+func isDefault(s *schema.Schema, value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	if s.Default != nil {
+		return reflect.DeepEqual(value, s.Default)
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return len(listValue(value)) == 0
+}
+
+// listValue returns the elements of a list or set field value.
+func listValue(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+// valueTokens returns the HCL expression for a primitive, list, set or map field value.
+func valueTokens(s *schema.Schema, value interface{}) (hclwrite.Tokens, bool) {
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		items := make([]hclwrite.Tokens, 0)
+		for _, item := range listValue(value) {
+			if tokens, ok := primitiveTokens(item); ok {
+				items = append(items, tokens)
+			}
+		}
+		return hclwrite.TokensForTuple(items), true
+	case schema.TypeMap:
+		valueMap, _ := value.(map[string]interface{})
+		keys := make([]string, 0, len(valueMap))
+		for key := range valueMap {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		attrs := make([]hclwrite.ObjectAttrTokens, 0, len(keys))
+		for _, key := range keys {
+			if tokens, ok := primitiveTokens(valueMap[key]); ok {
+				attrs = append(attrs, hclwrite.ObjectAttrTokens{
+					Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+					Value: tokens,
+				})
+			}
+		}
+		return hclwrite.TokensForObject(attrs), true
+	}
+
+	return primitiveTokens(value)
+}
+
+// primitiveTokens returns the HCL literal for a string, number or bool. Multi-line strings that end in a
+// newline, such as scripts, are written as heredocs so they stay readable.
+func primitiveTokens(value interface{}) (hclwrite.Tokens, bool) {
+	switch v := value.(type) {
+	case string:
+		if strings.Contains(v, "\n") && strings.HasSuffix(v, "\n") {
+			return heredocTokens(v), true
+		}
+		return hclwrite.TokensForValue(cty.StringVal(v)), true
+	case int:
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v))), true
+	case float64:
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v)), true
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v)), true
+	}
+	return nil, false
+}
+
+// heredocTokens returns content as a heredoc, escaping template sequences and choosing a delimiter that does
+// not appear as a line of the content.
+func heredocTokens(content string) hclwrite.Tokens {
+	delimiter := "EOT"
+	for strings.Contains("\n"+content, "\n"+delimiter+"\n") {
+		delimiter += "_"
+	}
+
+	escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(content)
+
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOHeredoc, Bytes: []byte("<<" + delimiter + "\n")},
+		{Type: hclsyntax.TokenStringLit, Bytes: []byte(escaped)},
+		{Type: hclsyntax.TokenCHeredoc, Bytes: []byte(delimiter)},
+	}
+}
+
+// commentTokens returns a single line comment.
+func commentTokens(comment string) hclwrite.Tokens {
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte(comment + "\n")},
+	}
+}
@@ -0,0 +1,20 @@
+// gsxconnection_constructor.go
+package gsxconnection
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct applies the configured GSX connection settings over current, the settings read from Jamf Pro,
+// so that the keystore uploaded outside Terraform is sent back unchanged.
+func construct(d *schema.ResourceData, current *jamfpro.ResourceGSXConnection) *jamfpro.ResourceGSXConnection {
+	resource := *current
+
+	resource.Enabled = d.Get("enabled").(bool)
+	resource.Username = d.Get("username").(string)
+	resource.ServiceAccountNo = d.Get("service_account_no").(string)
+	resource.ShipToNo = d.Get("ship_to_no").(string)
+
+	return &resource
+}
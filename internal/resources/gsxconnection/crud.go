@@ -0,0 +1,116 @@
+package gsxconnection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// singletonID is the fixed ID of the one GSX connection in Jamf Pro.
+const singletonID = "jamfpro_gsx_connection_singleton"
+
+// create is responsible for bringing the Jamf Pro GSX connection under Terraform management.
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := apply(ctx, d, meta, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(singletonID)
+
+	return readNoCleanup(ctx, d, meta)
+}
+
+// read is responsible for reading the current state of the Jamf Pro GSX connection.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	var diags diag.Diagnostics
+
+	d.SetId(singletonID)
+
+	var response *jamfpro.ResourceGSXConnection
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		response, apiErr = client.GetGSXConnectionInformation()
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return append(diags, common.HandleResourceNotFoundError(err, d, cleanup)...)
+	}
+
+	return append(diags, updateState(d, response)...)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating the Jamf Pro GSX connection.
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := apply(ctx, d, meta, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return readNoCleanup(ctx, d, meta)
+}
+
+// apply reads the current GSX connection and patches the configured settings over it.
+func apply(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) diag.Diagnostics {
+	jamfClient := meta.(*jamfpro.Client)
+
+	err := retry.RetryContext(ctx, d.Timeout(timeout), func() *retry.RetryError {
+		current, apiErr := jamfClient.GetGSXConnectionInformation()
+		if apiErr != nil {
+			return client.RetryError(apiErr)
+		}
+
+		if _, apiErr = jamfClient.UpdateGSXConnectionInformation(construct(d, current)); apiErr != nil {
+			return client.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to apply Jamf Pro GSX connection settings after retries: %v", err))
+	}
+
+	return nil
+}
+
+// delete is responsible for 'deleting' the Jamf Pro GSX connection.
+// The connection is a tenant setting rather than an object, so it is only removed from the Terraform state.
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "GSX connection removed from state only",
+		Detail:   "The GSX connection settings are left as they are in Jamf Pro. Set enabled = false and apply first to switch the connection off.",
+	})
+
+	d.SetId("")
+
+	return diags
+}
+
+// importState adopts the GSX connection whatever ID is given on import, as there is only one per Jamf Pro instance.
+func importState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(singletonID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,64 @@
+// gsxconnection_resource.go
+package gsxconnection
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceJamfProGSXConnection defines the schema and RU operations for managing the Jamf Pro GSX connection in Terraform.
+func ResourceJamfProGSXConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether Jamf Pro looks up warranty and purchasing information from Apple GSX.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Apple ID used to connect to GSX.",
+			},
+			"service_account_no": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The GSX service account number.",
+			},
+			"ship_to_no": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The GSX ship-to number.",
+			},
+			"keystore_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The file name of the GSX certificate keystore uploaded to Jamf Pro.",
+			},
+			"keystore_expiration_epoch": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "When the GSX certificate keystore expires, in milliseconds since the Unix epoch.",
+			},
+			"keystore_error_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The error Jamf Pro reports for the GSX certificate keystore, if any.",
+			},
+		},
+	}
+}
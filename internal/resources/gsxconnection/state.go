@@ -0,0 +1,31 @@
+// gsxconnection_state.go
+package gsxconnection
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest GSX connection information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceGSXConnection) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	gsxData := map[string]interface{}{
+		"enabled":                   resp.Enabled,
+		"username":                  resp.Username,
+		"service_account_no":        resp.ServiceAccountNo,
+		"ship_to_no":                resp.ShipToNo,
+		"keystore_name":             resp.GsxKeystore.Name,
+		"keystore_expiration_epoch": resp.GsxKeystore.ExpirationEpoch,
+		"keystore_error_message":    resp.GsxKeystore.ErrorMessage,
+	}
+
+	for key, val := range gsxData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
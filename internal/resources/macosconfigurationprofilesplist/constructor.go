@@ -2,18 +2,19 @@
 package macosconfigurationprofilesplist
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"html"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProMacOSConfigurationProfilePlist constructs a ResourceMacOSConfigurationProfile object from the provided schema data.
-func constructJamfProMacOSConfigurationProfilePlist(d *schema.ResourceData) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
+func constructJamfProMacOSConfigurationProfilePlist(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
 	resource := &jamfpro.ResourceMacOSConfigurationProfile{
 		General: jamfpro.MacOSConfigurationProfileSubsetGeneral{
 			Name:               d.Get("name").(string),
@@ -45,7 +46,7 @@ func constructJamfProMacOSConfigurationProfilePlist(d *schema.ResourceData) (*ja
 		return nil, fmt.Errorf("failed to marshal Jamf Pro macOS Configuration Profile '%s' to XML: %v", resource.General.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro macOS Configuration Profile XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro macOS Configuration Profile XML", string(resourceXML))
 
 	return resource, nil
 }
@@ -58,22 +59,22 @@ func constructMacOSConfigurationProfileSubsetScope(data map[string]interface{})
 	}
 
 	if computerIDs, ok := data["computer_ids"]; ok {
-		scope.Computers = constructComputers(computerIDs.([]interface{}))
+		scope.Computers = constructComputers(sharedschemas.ListOrSetItems(computerIDs))
 	}
 	if computerGroupIDs, ok := data["computer_group_ids"]; ok {
-		scope.ComputerGroups = constructScopeEntitiesFromIds(computerGroupIDs.([]interface{}))
+		scope.ComputerGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(computerGroupIDs))
 	}
 	if buildingIDs, ok := data["building_ids"]; ok {
-		scope.Buildings = constructScopeEntitiesFromIds(buildingIDs.([]interface{}))
+		scope.Buildings = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(buildingIDs))
 	}
 	if departmentIDs, ok := data["department_ids"]; ok {
-		scope.Departments = constructScopeEntitiesFromIds(departmentIDs.([]interface{}))
+		scope.Departments = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(departmentIDs))
 	}
 	if jssUserIDs, ok := data["jss_user_ids"]; ok {
-		scope.JSSUsers = constructScopeEntitiesFromIds(jssUserIDs.([]interface{}))
+		scope.JSSUsers = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(jssUserIDs))
 	}
 	if jssUserGroupIDs, ok := data["jss_user_group_ids"]; ok {
-		scope.JSSUserGroups = constructScopeEntitiesFromIds(jssUserGroupIDs.([]interface{}))
+		scope.JSSUserGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(jssUserGroupIDs))
 	}
 
 	if limitations, ok := data["limitations"]; ok && len(limitations.([]interface{})) > 0 {
@@ -81,11 +82,8 @@ func constructMacOSConfigurationProfileSubsetScope(data map[string]interface{})
 		scope.Limitations = constructLimitations(limitationData)
 	}
 
-	if exclusions, ok := data["exclusions"]; ok && len(exclusions.([]interface{})) > 0 {
-		exclusionData := exclusions.([]interface{})[0]
-		if exclusionMap, ok := exclusionData.(map[string]interface{}); ok {
-			scope.Exclusions = constructExclusions(exclusionMap)
-		}
+	if exclusionData, ok := sharedschemas.GetScopeExclusions(data); ok {
+		scope.Exclusions = constructExclusions(exclusionData)
 	}
 
 	return scope
@@ -96,16 +94,16 @@ func constructLimitations(data map[string]interface{}) jamfpro.MacOSConfiguratio
 	limitations := jamfpro.MacOSConfigurationProfileSubsetLimitations{}
 
 	if userNames, ok := data["directory_service_or_local_usernames"]; ok {
-		limitations.Users = constructScopeEntitiesFromIdsFromNames(userNames.([]interface{}))
+		limitations.Users = constructScopeEntitiesFromIdsFromNames(sharedschemas.ListOrSetItems(userNames))
 	}
 	if userGroupIDs, ok := data["directory_service_usergroup_ids"]; ok {
-		limitations.UserGroups = constructScopeEntitiesFromIds(userGroupIDs.([]interface{}))
+		limitations.UserGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(userGroupIDs))
 	}
 	if networkSegmentIDs, ok := data["network_segment_ids"]; ok {
-		limitations.NetworkSegments = constructNetworkSegments(networkSegmentIDs.([]interface{}))
+		limitations.NetworkSegments = constructNetworkSegments(sharedschemas.ListOrSetItems(networkSegmentIDs))
 	}
 	if ibeaconIDs, ok := data["ibeacon_ids"]; ok {
-		limitations.IBeacons = constructScopeEntitiesFromIds(ibeaconIDs.([]interface{}))
+		limitations.IBeacons = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(ibeaconIDs))
 	}
 
 	return limitations
@@ -115,29 +113,29 @@ func constructLimitations(data map[string]interface{}) jamfpro.MacOSConfiguratio
 func constructExclusions(data map[string]interface{}) jamfpro.MacOSConfigurationProfileSubsetExclusions {
 	exclusions := jamfpro.MacOSConfigurationProfileSubsetExclusions{}
 
-	if computerIDs, ok := data["computer_ids"]; ok && len(computerIDs.([]interface{})) > 0 {
-		exclusions.Computers = constructComputers(computerIDs.([]interface{}))
+	if computerIDs, ok := data["computer_ids"]; ok && len(sharedschemas.ListOrSetItems(computerIDs)) > 0 {
+		exclusions.Computers = constructComputers(sharedschemas.ListOrSetItems(computerIDs))
 	}
-	if computerGroupIDs, ok := data["computer_group_ids"]; ok && len(computerGroupIDs.([]interface{})) > 0 {
-		exclusions.ComputerGroups = constructScopeEntitiesFromIds(computerGroupIDs.([]interface{}))
+	if computerGroupIDs, ok := data["computer_group_ids"]; ok && len(sharedschemas.ListOrSetItems(computerGroupIDs)) > 0 {
+		exclusions.ComputerGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(computerGroupIDs))
 	}
-	if userIDs, ok := data["jss_user_ids"]; ok && len(userIDs.([]interface{})) > 0 {
-		exclusions.JSSUsers = constructScopeEntitiesFromIds(userIDs.([]interface{}))
+	if userIDs, ok := data["jss_user_ids"]; ok && len(sharedschemas.ListOrSetItems(userIDs)) > 0 {
+		exclusions.JSSUsers = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(userIDs))
 	}
-	if userGroupIDs, ok := data["jss_user_group_ids"]; ok && len(userGroupIDs.([]interface{})) > 0 {
-		exclusions.JSSUserGroups = constructScopeEntitiesFromIds(userGroupIDs.([]interface{}))
+	if userGroupIDs, ok := data["jss_user_group_ids"]; ok && len(sharedschemas.ListOrSetItems(userGroupIDs)) > 0 {
+		exclusions.JSSUserGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(userGroupIDs))
 	}
-	if buildingIDs, ok := data["building_ids"]; ok && len(buildingIDs.([]interface{})) > 0 {
-		exclusions.Buildings = constructScopeEntitiesFromIds(buildingIDs.([]interface{}))
+	if buildingIDs, ok := data["building_ids"]; ok && len(sharedschemas.ListOrSetItems(buildingIDs)) > 0 {
+		exclusions.Buildings = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(buildingIDs))
 	}
-	if departmentIDs, ok := data["department_ids"]; ok && len(departmentIDs.([]interface{})) > 0 {
-		exclusions.Departments = constructScopeEntitiesFromIds(departmentIDs.([]interface{}))
+	if departmentIDs, ok := data["department_ids"]; ok && len(sharedschemas.ListOrSetItems(departmentIDs)) > 0 {
+		exclusions.Departments = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(departmentIDs))
 	}
-	if networkSegmentIDs, ok := data["network_segment_ids"]; ok && len(networkSegmentIDs.([]interface{})) > 0 {
-		exclusions.NetworkSegments = constructNetworkSegments(networkSegmentIDs.([]interface{}))
+	if networkSegmentIDs, ok := data["network_segment_ids"]; ok && len(sharedschemas.ListOrSetItems(networkSegmentIDs)) > 0 {
+		exclusions.NetworkSegments = constructNetworkSegments(sharedschemas.ListOrSetItems(networkSegmentIDs))
 	}
-	if ibeaconIDs, ok := data["ibeacon_ids"]; ok && len(ibeaconIDs.([]interface{})) > 0 {
-		exclusions.IBeacons = constructScopeEntitiesFromIds(ibeaconIDs.([]interface{}))
+	if ibeaconIDs, ok := data["ibeacon_ids"]; ok && len(sharedschemas.ListOrSetItems(ibeaconIDs)) > 0 {
+		exclusions.IBeacons = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(ibeaconIDs))
 	}
 
 	return exclusions
@@ -230,3 +228,8 @@ func constructScopeEntitiesFromIdsFromNames(names []interface{}) []jamfpro.MacOS
 	}
 	return scopeEntities
 }
+
+// Render returns the macOS configuration profile payload built from d without calling Jamf Pro.
+func Render(ctx context.Context, d *schema.ResourceData) (interface{}, error) {
+	return constructJamfProMacOSConfigurationProfilePlist(ctx, d)
+}
@@ -7,7 +7,9 @@ import (
 	"sync"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -29,7 +31,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistCreate(ctx context.Context, d
 	// Lock the mutex to ensure only one profile plust create can run this function at a time
 	mu.Lock()
 	defer mu.Unlock()
-	resource, err := constructJamfProMacOSConfigurationProfilePlist(d)
+	resource, err := constructJamfProMacOSConfigurationProfilePlist(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile: %v", err))
 	}
@@ -96,11 +98,15 @@ func resourceJamfProMacOSConfigurationProfilesPlistUpdate(ctx context.Context, d
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	resource, err := constructJamfProMacOSConfigurationProfilePlist(d)
+	resource, err := constructJamfProMacOSConfigurationProfilePlist(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile for update: %v", err))
 	}
 
+	if sharedschemas.RedeployTriggered(d) {
+		resource.General.RedeployOnUpdate = sharedschemas.RedeployToAll
+	}
+
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := client.UpdateMacOSConfigurationProfileByID(resourceID, resource)
 		if apiErr != nil {
@@ -127,6 +133,9 @@ func resourceJamfProMacOSConfigurationProfilesPlistDelete(ctx context.Context, d
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		apiErr := client.DeleteMacOSConfigurationProfileByID(resourceID)
 		if apiErr != nil {
+			if !common.NameFallbackEnabled(client) {
+				return jamfclient.RetryError(apiErr)
+			}
 			apiErrByName := client.DeleteMacOSConfigurationProfileByName(resourceName)
 			if apiErrByName != nil {
 				return retry.RetryableError(apiErrByName)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/configurationprofiles/datavalidators"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/configurationprofiles/plist"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -174,7 +175,7 @@ func validateAllComputersScope(_ context.Context, diff *schema.ResourceDiff, _ i
 	if allComputers {
 		fieldsToCheck := []string{"computer_ids", "computer_group_ids"}
 		for _, field := range fieldsToCheck {
-			if value, exists := scope[field]; exists && len(value.([]interface{})) > 0 {
+			if value, exists := scope[field]; exists && len(sharedschemas.ListOrSetItems(value)) > 0 {
 				return fmt.Errorf("in 'jamfpro_macos_configuration_profile_plist.%s': when 'all_computers' scope is set to true, '%s' should not be set", resourceName, field)
 			}
 		}
@@ -196,7 +197,7 @@ func validateAllUsersScope(_ context.Context, diff *schema.ResourceDiff, _ inter
 	if allComputers {
 		fieldsToCheck := []string{"jss_user_ids", "jss_user_group_ids", "building_ids", "department_ids"}
 		for _, field := range fieldsToCheck {
-			if value, exists := scope[field]; exists && len(value.([]interface{})) > 0 {
+			if value, exists := scope[field]; exists && len(sharedschemas.ListOrSetItems(value)) > 0 {
 				return fmt.Errorf("in 'jamfpro_macos_configuration_profile_plist.%s': when 'all_jss_users' scope is set to true, '%s' should not be set", resourceName, field)
 			}
 		}
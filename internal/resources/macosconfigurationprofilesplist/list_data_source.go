@@ -0,0 +1,28 @@
+package macosconfigurationprofilesplist
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProMacOSConfigurationProfilesPlistList provides the ID and name of every macOS configuration profile in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProMacOSConfigurationProfilesPlistList() *schema.Resource {
+	return common.ListDataSource("macOS configuration profile", listNames)
+}
+
+// listNames returns the ID and name of every macOS configuration profile in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetMacOSConfigurationProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Results))
+	for _, item := range response.Results {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -85,6 +85,7 @@ func ResourceJamfProMacOSConfigurationProfilesPlist() *schema.Resource {
 					"Required when the configuration profile is a non Jamf Pro source, e.g iMazing. Removing" +
 					"this may cause unexpected stating behaviour.",
 			},
+			"redeploy_on_trigger": sharedschemas.GetSharedSchemaRedeployOnTrigger(),
 			"redeploy_on_update": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -105,6 +106,8 @@ func ResourceJamfProMacOSConfigurationProfilesPlist() *schema.Resource {
 					return warns, errs
 				},
 			},
+			"targeted_group_count": sharedschemas.GetSharedSchemaTargetedGroupCount(),
+			"excluded_group_count": sharedschemas.GetSharedSchemaExcludedGroupCount(),
 			"scope": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
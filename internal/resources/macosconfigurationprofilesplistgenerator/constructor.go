@@ -2,22 +2,23 @@
 package macosconfigurationprofilesplistgenerator
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"html"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/configurationprofiles/plist"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProMacOSConfigurationProfilesPlistGenerator constructs a ResourceMacOSConfigurationProfile object from the provided schema data.
-func constructJamfProMacOSConfigurationProfilesPlistGenerator(d *schema.ResourceData) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
+func constructJamfProMacOSConfigurationProfilesPlistGenerator(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMacOSConfigurationProfile, error) {
 	var resource *jamfpro.ResourceMacOSConfigurationProfile
 
-	plistXML, err := plist.ConvertHCLToPlist(d)
+	plistXML, err := plist.ConvertHCLToPlist(ctx, d)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate plist from payloads: %v", err)
 	}
@@ -53,7 +54,7 @@ func constructJamfProMacOSConfigurationProfilesPlistGenerator(d *schema.Resource
 		return nil, fmt.Errorf("failed to marshal Jamf Pro macOS Configuration Profile '%s' to XML: %v", resource.General.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro macOS Configuration Profile XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro macOS Configuration Profile XML", string(resourceXML))
 
 	return resource, nil
 }
@@ -66,22 +67,22 @@ func constructMacOSConfigurationProfileSubsetScope(data map[string]interface{})
 	}
 
 	if computerIDs, ok := data["computer_ids"]; ok {
-		scope.Computers = constructComputers(computerIDs.([]interface{}))
+		scope.Computers = constructComputers(sharedschemas.ListOrSetItems(computerIDs))
 	}
 	if computerGroupIDs, ok := data["computer_group_ids"]; ok {
-		scope.ComputerGroups = constructScopeEntitiesFromIds(computerGroupIDs.([]interface{}))
+		scope.ComputerGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(computerGroupIDs))
 	}
 	if buildingIDs, ok := data["building_ids"]; ok {
-		scope.Buildings = constructScopeEntitiesFromIds(buildingIDs.([]interface{}))
+		scope.Buildings = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(buildingIDs))
 	}
 	if departmentIDs, ok := data["department_ids"]; ok {
-		scope.Departments = constructScopeEntitiesFromIds(departmentIDs.([]interface{}))
+		scope.Departments = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(departmentIDs))
 	}
 	if jssUserIDs, ok := data["jss_user_ids"]; ok {
-		scope.JSSUsers = constructScopeEntitiesFromIds(jssUserIDs.([]interface{}))
+		scope.JSSUsers = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(jssUserIDs))
 	}
 	if jssUserGroupIDs, ok := data["jss_user_group_ids"]; ok {
-		scope.JSSUserGroups = constructScopeEntitiesFromIds(jssUserGroupIDs.([]interface{}))
+		scope.JSSUserGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(jssUserGroupIDs))
 	}
 
 	if limitations, ok := data["limitations"]; ok && len(limitations.([]interface{})) > 0 {
@@ -89,8 +90,7 @@ func constructMacOSConfigurationProfileSubsetScope(data map[string]interface{})
 		scope.Limitations = constructLimitations(limitationData)
 	}
 
-	if exclusions, ok := data["exclusions"]; ok && len(exclusions.([]interface{})) > 0 {
-		exclusionData := exclusions.([]interface{})[0].(map[string]interface{})
+	if exclusionData, ok := sharedschemas.GetScopeExclusions(data); ok {
 		scope.Exclusions = constructExclusions(exclusionData)
 	}
 
@@ -102,16 +102,16 @@ func constructLimitations(data map[string]interface{}) jamfpro.MacOSConfiguratio
 	limitations := jamfpro.MacOSConfigurationProfileSubsetLimitations{}
 
 	if userNames, ok := data["directory_service_or_local_usernames"]; ok {
-		limitations.Users = constructScopeEntitiesFromIdsFromNames(userNames.([]interface{}))
+		limitations.Users = constructScopeEntitiesFromIdsFromNames(sharedschemas.ListOrSetItems(userNames))
 	}
 	if userGroupIDs, ok := data["directory_service_usergroup_ids"]; ok {
-		limitations.UserGroups = constructScopeEntitiesFromIds(userGroupIDs.([]interface{}))
+		limitations.UserGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(userGroupIDs))
 	}
 	if networkSegmentIDs, ok := data["network_segment_ids"]; ok {
-		limitations.NetworkSegments = constructNetworkSegments(networkSegmentIDs.([]interface{}))
+		limitations.NetworkSegments = constructNetworkSegments(sharedschemas.ListOrSetItems(networkSegmentIDs))
 	}
 	if ibeaconIDs, ok := data["ibeacon_ids"]; ok {
-		limitations.IBeacons = constructScopeEntitiesFromIds(ibeaconIDs.([]interface{}))
+		limitations.IBeacons = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(ibeaconIDs))
 	}
 
 	return limitations
@@ -122,28 +122,28 @@ func constructExclusions(data map[string]interface{}) jamfpro.MacOSConfiguration
 	exclusions := jamfpro.MacOSConfigurationProfileSubsetExclusions{}
 
 	if computerIDs, ok := data["computer_ids"]; ok {
-		exclusions.Computers = constructComputers(computerIDs.([]interface{}))
+		exclusions.Computers = constructComputers(sharedschemas.ListOrSetItems(computerIDs))
 	}
 	if computerGroupIDs, ok := data["computer_group_ids"]; ok {
-		exclusions.ComputerGroups = constructScopeEntitiesFromIds(computerGroupIDs.([]interface{}))
+		exclusions.ComputerGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(computerGroupIDs))
 	}
 	if userIDs, ok := data["jss_user_ids"]; ok {
-		exclusions.JSSUsers = constructScopeEntitiesFromIds(userIDs.([]interface{}))
+		exclusions.JSSUsers = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(userIDs))
 	}
 	if userGroupIDs, ok := data["jss_user_group_ids"]; ok {
-		exclusions.JSSUserGroups = constructScopeEntitiesFromIds(userGroupIDs.([]interface{}))
+		exclusions.JSSUserGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(userGroupIDs))
 	}
 	if buildingIDs, ok := data["building_ids"]; ok {
-		exclusions.Buildings = constructScopeEntitiesFromIds(buildingIDs.([]interface{}))
+		exclusions.Buildings = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(buildingIDs))
 	}
 	if departmentIDs, ok := data["department_ids"]; ok {
-		exclusions.Departments = constructScopeEntitiesFromIds(departmentIDs.([]interface{}))
+		exclusions.Departments = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(departmentIDs))
 	}
 	if networkSegmentIDs, ok := data["network_segment_ids"]; ok {
-		exclusions.NetworkSegments = constructNetworkSegments(networkSegmentIDs.([]interface{}))
+		exclusions.NetworkSegments = constructNetworkSegments(sharedschemas.ListOrSetItems(networkSegmentIDs))
 	}
 	if ibeaconIDs, ok := data["ibeacon_ids"]; ok {
-		exclusions.IBeacons = constructScopeEntitiesFromIds(ibeaconIDs.([]interface{}))
+		exclusions.IBeacons = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(ibeaconIDs))
 	}
 
 	return exclusions
@@ -231,3 +231,8 @@ func constructScopeEntitiesFromIdsFromNames(names []interface{}) []jamfpro.MacOS
 	}
 	return scopeEntities
 }
+
+// Render returns the generated macOS configuration profile payload built from d without calling Jamf Pro.
+func Render(ctx context.Context, d *schema.ResourceData) (interface{}, error) {
+	return constructJamfProMacOSConfigurationProfilesPlistGenerator(ctx, d)
+}
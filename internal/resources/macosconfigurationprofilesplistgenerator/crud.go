@@ -8,7 +8,9 @@ import (
 	"sync"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,7 +32,7 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorCreate(ctx context.C
 	// Lock the mutex to ensure only one profile plust create can run this function at a time
 	mu.Lock()
 	defer mu.Unlock()
-	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(d)
+	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile: %v", err))
 	}
@@ -97,11 +99,15 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorUpdate(ctx context.C
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(d)
+	resource, err := constructJamfProMacOSConfigurationProfilesPlistGenerator(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro macOS Configuration Profile for update: %v", err))
 	}
 
+	if sharedschemas.RedeployTriggered(d) {
+		resource.General.RedeployOnUpdate = sharedschemas.RedeployToAll
+	}
+
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := client.UpdateMacOSConfigurationProfileByID(resourceID, resource)
 		if apiErr != nil {
@@ -129,6 +135,9 @@ func resourceJamfProMacOSConfigurationProfilesPlistGeneratorDelete(ctx context.C
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		apiErr := client.DeleteMacOSConfigurationProfileByID(resourceID)
 		if apiErr != nil {
+			if !common.NameFallbackEnabled(client) {
+				return jamfclient.RetryError(apiErr)
+			}
 			apiErrByName := client.DeleteMacOSConfigurationProfileByName(resourceName)
 			if apiErrByName != nil {
 				return retry.RetryableError(apiErrByName)
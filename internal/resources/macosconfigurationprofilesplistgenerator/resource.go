@@ -300,6 +300,7 @@ func ResourceJamfProMacOSConfigurationProfilesPlistGenerator() *schema.Resource
 					},
 				},
 			},
+			"redeploy_on_trigger": sharedschemas.GetSharedSchemaRedeployOnTrigger(),
 			"redeploy_on_update": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -320,6 +321,8 @@ func ResourceJamfProMacOSConfigurationProfilesPlistGenerator() *schema.Resource
 					return warns, errs
 				},
 			},
+			"targeted_group_count": sharedschemas.GetSharedSchemaTargetedGroupCount(),
+			"excluded_group_count": sharedschemas.GetSharedSchemaExcludedGroupCount(),
 			"scope": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
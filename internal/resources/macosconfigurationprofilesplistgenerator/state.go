@@ -7,6 +7,7 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/configurationprofiles/plist"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -48,12 +49,14 @@ func updateTerraformState(d *schema.ResourceData, resp *jamfpro.ResourceMacOSCon
 	d.Set("category_id", resp.General.Category.ID)
 
 	// Preparing and setting scope data
-	if scopeData, err := setScope(resp); err != nil {
+	if scopeData, err := setScope(d, resp); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	} else if err := d.Set("scope", []interface{}{scopeData}); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	diags = append(diags, sharedschemas.StateScopeGroupCounts(d, len(resp.Scope.ComputerGroups), len(resp.Scope.Exclusions.ComputerGroups))...)
+
 	// Check if the self_service block is provided and set it in the state accordingly
 	defaultSelfService := jamfpro.MacOSConfigurationProfileSubsetSelfService{}
 	if !compareSelfService(resp.SelfService, defaultSelfService) {
@@ -84,7 +87,7 @@ func updateTerraformState(d *schema.ResourceData, resp *jamfpro.ResourceMacOSCon
 }
 
 // setScope converts the scope structure into a format suitable for setting in the Terraform state.
-func setScope(resp *jamfpro.ResourceMacOSConfigurationProfile) (map[string]interface{}, error) {
+func setScope(d *schema.ResourceData, resp *jamfpro.ResourceMacOSConfigurationProfile) (map[string]interface{}, error) {
 	scopeData := map[string]interface{}{
 		"all_computers": resp.Scope.AllComputers,
 		"all_jss_users": resp.Scope.AllJSSUsers,
@@ -108,7 +111,7 @@ func setScope(resp *jamfpro.ResourceMacOSConfigurationProfile) (map[string]inter
 	}
 
 	// Gather exclusions
-	exclusionsData, err := setExclusions(resp.Scope.Exclusions)
+	exclusionsData, err := setExclusions(d, resp.Scope.Exclusions)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +162,7 @@ func setLimitations(limitations jamfpro.MacOSConfigurationProfileSubsetLimitatio
 }
 
 // setExclusions collects and formats exclusion data for the Terraform state.
-func setExclusions(exclusions jamfpro.MacOSConfigurationProfileSubsetExclusions) ([]map[string]interface{}, error) {
+func setExclusions(d *schema.ResourceData, exclusions jamfpro.MacOSConfigurationProfileSubsetExclusions) ([]map[string]interface{}, error) {
 	result := map[string]interface{}{}
 
 	if len(exclusions.Computers) > 0 {
@@ -232,11 +235,7 @@ func setExclusions(exclusions jamfpro.MacOSConfigurationProfileSubsetExclusions)
 		}
 	}
 
-	if len(result) == 0 {
-		return nil, nil
-	}
-
-	return []map[string]interface{}{result}, nil
+	return sharedschemas.StateScopeExclusions(d, result), nil
 }
 
 // setSelfService converts the self-service structure into a format suitable for setting in the Terraform state.
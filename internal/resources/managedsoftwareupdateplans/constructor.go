@@ -0,0 +1,44 @@
+package managedsoftwareupdateplans
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a ResourceManagedSoftwareUpdatePlan object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceManagedSoftwareUpdatePlan, error) {
+	group := d.Get("group").([]interface{})[0].(map[string]interface{})
+
+	resource := &jamfpro.ResourceManagedSoftwareUpdatePlan{
+		Group: jamfpro.ResourcManagedSoftwareUpdatePlanObject{
+			GroupId:    group["group_id"].(string),
+			ObjectType: group["object_type"].(string),
+		},
+		Config: jamfpro.ResourcManagedSoftwareUpdatePlanConfig{
+			UpdateAction:              d.Get("update_action").(string),
+			VersionType:               d.Get("version_type").(string),
+			SpecificVersion:           "NO_SPECIFIC_VERSION",
+			BuildVersion:              d.Get("build_version").(string),
+			MaxDeferrals:              d.Get("max_deferrals").(int),
+			ForceInstallLocalDateTime: d.Get("force_install_local_date_time").(string),
+		},
+	}
+
+	if v, ok := d.GetOk("specific_version"); ok {
+		resource.Config.SpecificVersion = v.(string)
+	}
+
+	resourceJSON, err := json.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Managed Software Update Plan to JSON: %v", err)
+	}
+
+	common.LogConstructedPayload(ctx, "Jamf Pro Managed Software Update Plan JSON", string(resourceJSON))
+
+	return resource, nil
+}
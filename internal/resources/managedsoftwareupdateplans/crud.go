@@ -0,0 +1,100 @@
+package managedsoftwareupdateplans
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mu serialises creates during parallel runs, as each create may need to enable the feature toggle first
+var mu sync.Mutex
+
+// create is responsible for creating a new Jamf Pro Managed Software Update Plan in the remote system.
+// The function:
+// 1. Ensures the Managed Software Update feature toggle is enabled.
+// 2. Constructs the plan using the provided Terraform configuration.
+// 3. Calls the API to create the plan for the configured group.
+// 4. Updates the Terraform state with the UUID of the newly created plan.
+// 5. Initiates a read operation to synchronize the Terraform state with the actual state in Jamf Pro.
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	var diags diag.Diagnostics
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err := checkAndEnableManagedSoftwareUpdateFeatureToggle(ctx, client); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to ensure Jamf Pro Managed Software Update feature toggle is enabled: %v", err))
+	}
+
+	resource, err := construct(ctx, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Managed Software Update Plan: %v", err))
+	}
+
+	var creationResponse *jamfpro.ResponseManagedSoftwareUpdatePlanCreate
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		var apiErr error
+		creationResponse, apiErr = client.CreateManagedSoftwareUpdatePlanByGroupID(resource)
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Managed Software Update Plan for group '%s' after retries: %v", resource.Group.GroupId, err))
+	}
+
+	if len(creationResponse.Plans) == 0 {
+		return diag.FromErr(fmt.Errorf("jamf Pro returned no plan when creating a Managed Software Update Plan for group '%s'", resource.Group.GroupId))
+	}
+
+	d.SetId(creationResponse.Plans[0].PlanID)
+
+	return append(diags, readNoCleanup(ctx, d, meta)...)
+}
+
+// read is responsible for reading the current state of a Jamf Pro Managed Software Update Plan from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup bool) diag.Diagnostics {
+	return common.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		meta.(*jamfpro.Client).GetManagedSoftwareUpdatePlanByUUID,
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// delete is responsible for 'deleting' a Jamf Pro Managed Software Update Plan.
+// Jamf Pro has no endpoint to remove a plan, so it is only removed from the Terraform state.
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Managed software update plan removed from state only",
+		Detail:   fmt.Sprintf("Jamf Pro does not support deleting managed software update plans. Plan '%s' remains in Jamf Pro until it completes or is superseded.", d.Id()),
+	})
+
+	d.SetId("")
+
+	return diags
+}
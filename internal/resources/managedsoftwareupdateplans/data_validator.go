@@ -0,0 +1,74 @@
+package managedsoftwareupdateplans
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// localDateTimeLayout is the format Jamf Pro expects for force_install_local_date_time. The value
+// is interpreted in each device's local time zone, so no offset is accepted.
+const localDateTimeLayout = "2006-01-02T15:04:05"
+
+// mainCustomDiffFunc orchestrates all custom diff validations.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if err := validateVersionFields(ctx, diff, i); err != nil {
+		return err
+	}
+
+	if err := validateUpdateActionFields(ctx, diff, i); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateVersionFields ensures specific_version is set when the version type requires it.
+func validateVersionFields(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	versionType := diff.Get("version_type").(string)
+	specificVersion := diff.Get("specific_version").(string)
+
+	if (versionType == "SPECIFIC_VERSION" || versionType == "CUSTOM_VERSION") && (specificVersion == "" || specificVersion == "NO_SPECIFIC_VERSION") {
+		return fmt.Errorf("in 'jamfpro_managed_software_update_plan': 'specific_version' must be set when 'version_type' is '%s'", versionType)
+	}
+
+	buildVersion := diff.GetRawConfig().GetAttr("build_version")
+	if !buildVersion.IsNull() && buildVersion.IsKnown() && buildVersion.AsString() != "" && versionType != "CUSTOM_VERSION" {
+		return fmt.Errorf("in 'jamfpro_managed_software_update_plan': 'build_version' can only be set when 'version_type' is 'CUSTOM_VERSION'")
+	}
+
+	return nil
+}
+
+// validateUpdateActionFields ensures max_deferrals and force_install_local_date_time are only
+// used with the update actions that honour them.
+func validateUpdateActionFields(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	updateAction := diff.Get("update_action").(string)
+	_, hasMaxDeferrals := diff.GetOk("max_deferrals")
+
+	if updateAction == "DOWNLOAD_INSTALL_ALLOW_DEFERRAL" && !hasMaxDeferrals {
+		return fmt.Errorf("in 'jamfpro_managed_software_update_plan': 'max_deferrals' must be set when 'update_action' is 'DOWNLOAD_INSTALL_ALLOW_DEFERRAL'")
+	}
+
+	if updateAction != "DOWNLOAD_INSTALL_ALLOW_DEFERRAL" && hasMaxDeferrals {
+		return fmt.Errorf("in 'jamfpro_managed_software_update_plan': 'max_deferrals' can only be set when 'update_action' is 'DOWNLOAD_INSTALL_ALLOW_DEFERRAL'")
+	}
+
+	return nil
+}
+
+// validateLocalDateTime ensures force_install_local_date_time is a local date and time without an offset.
+func validateLocalDateTime(v interface{}, k string) (warns []string, errs []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	if _, err := time.Parse(localDateTimeLayout, value); err != nil {
+		errs = append(errs, fmt.Errorf("%q must be in the format YYYY-MM-DDTHH:MM:SS without a time zone, got: %s", k, value))
+	}
+
+	return
+}
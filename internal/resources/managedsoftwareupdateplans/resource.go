@@ -0,0 +1,112 @@
+package managedsoftwareupdateplans
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProManagedSoftwareUpdatePlans defines the schema and CRUD operations for managing Jamf Pro
+// managed software update plans in Terraform. Jamf Pro has no endpoint to update or delete a plan, so
+// every configurable attribute forces a new plan and delete only removes the plan from state.
+func ResourceJamfProManagedSoftwareUpdatePlans() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the managed software update plan.",
+			},
+			"group": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The device group targeted by the managed software update plan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The ID of the Jamf Pro computer or mobile device group.",
+						},
+						"object_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"COMPUTER_GROUP", "MOBILE_DEVICE_GROUP"}, false),
+							Description:  "The type of the group (COMPUTER_GROUP or MOBILE_DEVICE_GROUP).",
+						},
+					},
+				},
+			},
+			"update_action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DOWNLOAD_ONLY", "DOWNLOAD_INSTALL", "DOWNLOAD_INSTALL_ALLOW_DEFERRAL", "DOWNLOAD_INSTALL_RESTART", "DOWNLOAD_INSTALL_SCHEDULE"}, false),
+				Description:  "The software update action to perform.",
+			},
+			"version_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"LATEST_MAJOR", "LATEST_MINOR", "LATEST_ANY", "SPECIFIC_VERSION", "CUSTOM_VERSION"}, false),
+				Description:  "The type of version to update to.",
+			},
+			"specific_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The OS version to update to, e.g. '15.1'. Required when version_type is SPECIFIC_VERSION or CUSTOM_VERSION. Jamf Pro reports NO_SPECIFIC_VERSION otherwise.",
+			},
+			"build_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The build version to update to. Only applicable when version_type is CUSTOM_VERSION.",
+			},
+			"max_deferrals": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of times a user may defer the update. Required when update_action is DOWNLOAD_INSTALL_ALLOW_DEFERRAL.",
+			},
+			"force_install_local_date_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLocalDateTime,
+				Description:  "The local date and time on the device by which the update is forced, e.g. '2025-01-31T18:00:00'.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current state of the plan as reported by Jamf Pro, e.g. 'PlanAccepted' or 'PlanFailed'.",
+			},
+			"error_reasons": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The reasons reported by Jamf Pro when the plan has failed.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
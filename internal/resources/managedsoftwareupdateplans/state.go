@@ -0,0 +1,44 @@
+package managedsoftwareupdateplans
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Managed Software Update Plan information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResponseManagedSoftwareUpdatePlan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceData := map[string]interface{}{
+		"update_action":    resp.UpdateAction,
+		"version_type":     resp.VersionType,
+		"specific_version": resp.SpecificVersion,
+		"build_version":    resp.BuildVersion,
+		"max_deferrals":    resp.MaxDeferrals,
+		"status":           resp.Status.State,
+		"error_reasons":    resp.Status.ErrorReasons,
+	}
+
+	if resp.ForceInstallLocalDateTime != "" {
+		resourceData["force_install_local_date_time"] = resp.ForceInstallLocalDateTime
+	}
+
+	// Only group targets are reflected back, as the group block is the only supported target.
+	if resp.Device.ObjectType == "COMPUTER_GROUP" || resp.Device.ObjectType == "MOBILE_DEVICE_GROUP" {
+		resourceData["group"] = []interface{}{
+			map[string]interface{}{
+				"group_id":    resp.Device.DeviceId,
+				"object_type": resp.Device.ObjectType,
+			},
+		}
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,68 @@
+package mdmcommands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a ResourceMDMCommandRequest from the provided schema data. Options that do not apply to
+// command_type are left empty and omitted from the request.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMDMCommandRequest, error) {
+	commandType := d.Get("command_type").(string)
+
+	resource := &jamfpro.ResourceMDMCommandRequest{
+		CommandData: jamfpro.CommandData{
+			CommandType: commandType,
+		},
+	}
+
+	switch commandType {
+	case CommandTypeDeleteUser:
+		resource.CommandData.UserName = d.Get("user_name").(string)
+		resource.CommandData.ForceDeletion = d.Get("force_deletion").(bool)
+		resource.CommandData.DeleteAllUsers = d.Get("delete_all_users").(bool)
+		if resource.CommandData.UserName == "" && !resource.CommandData.DeleteAllUsers {
+			return nil, fmt.Errorf("%s requires user_name or delete_all_users", commandType)
+		}
+	case CommandTypeEnableLostMode:
+		resource.CommandData.LostModeMessage = d.Get("lost_mode_message").(string)
+		resource.CommandData.LostModePhone = d.Get("lost_mode_phone").(string)
+		resource.CommandData.LostModeFootnote = d.Get("lost_mode_footnote").(string)
+		if resource.CommandData.LostModeMessage == "" && resource.CommandData.LostModePhone == "" {
+			return nil, fmt.Errorf("%s requires lost_mode_message or lost_mode_phone", commandType)
+		}
+	case CommandTypeEraseDevice:
+		resource.CommandData.PIN = d.Get("pin").(string)
+		resource.CommandData.ObliterationBehavior = d.Get("obliteration_behavior").(string)
+		resource.CommandData.PreserveDataPlan = d.Get("preserve_data_plan").(bool)
+		resource.CommandData.DisallowProximitySetup = d.Get("disallow_proximity_setup").(bool)
+	case CommandTypeRestartDevice:
+		resource.CommandData.NotifyUser = d.Get("notify_user").(bool)
+		resource.CommandData.RebuildKernelCache = d.Get("rebuild_kernel_cache").(bool)
+	}
+
+	for _, v := range d.Get("management_ids").(*schema.Set).List() {
+		resource.ClientData = append(resource.ClientData, jamfpro.ClientData{
+			ManagementID: v.(string),
+		})
+	}
+
+	logged := *resource
+	if logged.CommandData.PIN != "" {
+		logged.CommandData.PIN = "********"
+	}
+
+	resourceJSON, err := json.MarshalIndent(logged, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro MDM Command '%s' to JSON: %v", commandType, err)
+	}
+
+	common.LogConstructedPayload(ctx, "Jamf Pro MDM Command JSON", string(resourceJSON))
+
+	return resource, nil
+}
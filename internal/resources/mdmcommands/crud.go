@@ -0,0 +1,61 @@
+package mdmcommands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create sends the MDM command once. It is not retried, as a command such as ERASE_DEVICE that Jamf Pro
+// accepted before a connection failed must not be queued a second time.
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	payload, err := construct(ctx, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro MDM Command: %v", err))
+	}
+
+	response, err := meta.(*jamfpro.Client).SendMDMCommandForCreationAndQueuing(payload)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to send Jamf Pro MDM Command '%s' to %d device(s): %v", payload.CommandData.CommandType, len(payload.ClientData), err))
+	}
+
+	// Jamf Pro does not return an ID for every command type; a generated ID keeps the resource addressable.
+	if response.ID != "" {
+		d.SetId(response.ID)
+	} else {
+		d.SetId(uuid.NewString())
+	}
+
+	commandData := map[string]interface{}{
+		"status":    "QUEUED",
+		"issued_at": time.Now().UTC().Format(time.RFC3339),
+		"href":      response.Href,
+	}
+
+	for key, val := range commandData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// read keeps the state recorded when the command was sent; a queued command has no settings to refresh.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// delete removes the command from the Terraform state. Jamf Pro is not contacted, as sent commands cannot be recalled.
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
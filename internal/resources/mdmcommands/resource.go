@@ -0,0 +1,153 @@
+package mdmcommands
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	CommandTypeDeleteUser     = "DELETE_USER"
+	CommandTypeEnableLostMode = "ENABLE_LOST_MODE"
+	CommandTypeEraseDevice    = "ERASE_DEVICE"
+	CommandTypeRestartDevice  = "RESTART_DEVICE"
+)
+
+// ResourceJamfProMDMCommands defines the schema for issuing a one-shot MDM command in Terraform. Every argument
+// forces replacement, so the command is sent when the resource is created and sent again whenever trigger or any
+// other argument changes. Destroying the resource only removes it from state, as a sent command cannot be recalled.
+func ResourceJamfProMDMCommands() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   read,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID Jamf Pro returned for the queued command.",
+			},
+			"command_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					CommandTypeDeleteUser,
+					CommandTypeEnableLostMode,
+					CommandTypeEraseDevice,
+					CommandTypeRestartDevice,
+				}, false),
+				Description: "The MDM command to send: DELETE_USER, ENABLE_LOST_MODE, ERASE_DEVICE or RESTART_DEVICE.",
+			},
+			"management_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "The management IDs of the computers or mobile devices that receive the command.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "An arbitrary value. Changing it sends the command again, for example from a remediation pipeline.",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "DELETE_USER: the user to delete.",
+			},
+			"force_deletion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "DELETE_USER: delete the user even if they have data that has not synced.",
+			},
+			"delete_all_users": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "DELETE_USER: delete every user on the device.",
+			},
+			"lost_mode_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ENABLE_LOST_MODE: the message shown on the lock screen.",
+			},
+			"lost_mode_phone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ENABLE_LOST_MODE: the phone number shown on the lock screen.",
+			},
+			"lost_mode_footnote": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ENABLE_LOST_MODE: the footnote shown on the lock screen.",
+			},
+			"pin": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "ERASE_DEVICE: the six digit PIN required to unlock a Mac after it is erased.",
+			},
+			"obliteration_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Default", "DoNotObliterate", "ObliterateWithWarning", "Always"}, false),
+				Description:  "ERASE_DEVICE: how a Mac falls back to obliteration if Erase All Content and Settings fails.",
+			},
+			"preserve_data_plan": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ERASE_DEVICE: keep the cellular data plan of a mobile device.",
+			},
+			"disallow_proximity_setup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ERASE_DEVICE: prevent Proximity Setup on the erased mobile device.",
+			},
+			"notify_user": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "RESTART_DEVICE: notify the user and let them postpone the restart.",
+			},
+			"rebuild_kernel_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "RESTART_DEVICE: rebuild the kernel cache of a Mac during the restart.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "QUEUED once Jamf Pro has accepted the command. Delivery to each device is reported in Jamf Pro, not refreshed here.",
+			},
+			"issued_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time, in RFC 3339 format, at which the command was sent.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Jamf Pro API link to the queued command.",
+			},
+		},
+	}
+}
@@ -0,0 +1,158 @@
+package mobiledeviceapplications
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct constructs a ResourceMobileDeviceApplication object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceApplication, error) {
+	resource := &jamfpro.ResourceMobileDeviceApplication{
+		General: jamfpro.MobileDeviceApplicationSubsetGeneral{
+			Name:                             d.Get("name").(string),
+			DisplayName:                      d.Get("display_name").(string),
+			Description:                      d.Get("description").(string),
+			BundleID:                         d.Get("bundle_id").(string),
+			Version:                          d.Get("version").(string),
+			OsType:                           d.Get("os_type").(string),
+			ITunesStoreURL:                   d.Get("app_store_url").(string),
+			ITunesCountryRegion:              d.Get("itunes_country_region").(string),
+			DeploymentType:                   d.Get("deployment_type").(string),
+			MakeAvailableAfterInstall:        d.Get("make_available_after_install").(bool),
+			DeployAsManagedApp:               d.Get("deploy_as_managed_app").(bool),
+			RemoveAppWhenMDMProfileIsRemoved: d.Get("remove_app_when_mdm_profile_is_removed").(bool),
+			PreventBackupOfAppData:           d.Get("prevent_backup_of_app_data").(bool),
+			KeepDescriptionAndIconUpToDate:   d.Get("keep_description_and_icon_up_to_date").(bool),
+			Free:                             d.Get("free").(bool),
+			TakeOverManagement:               d.Get("take_over_management").(bool),
+		},
+	}
+
+	resource.General.Site = sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int))
+	resource.General.Category = sharedschemas.ConstructSharedResourceCategory(d.Get("category_id").(int))
+
+	if v, ok := d.GetOk("vpp"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		vppData := v.([]interface{})[0].(map[string]interface{})
+		resource.General.VPP = jamfpro.MobileDeviceApplicationSubsetGeneralVPP{
+			AssignVPPDeviceBasedLicenses: vppData["assign_vpp_device_based_licenses"].(bool),
+			VPPAdminAccountID:            vppData["vpp_admin_account_id"].(int),
+		}
+	}
+
+	if v, ok := d.GetOk("app_configuration_preferences"); ok {
+		resource.General.AppConfiguration = jamfpro.MobileDeviceApplicationSubsetGeneralAppConfiguration{
+			Preferences: v.(string),
+		}
+	}
+
+	if v, ok := d.GetOk("self_service"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		selfServiceData := v.([]interface{})[0].(map[string]interface{})
+		resource.General.SelfService = jamfpro.MobileDeviceApplicationSubsetGeneralSelfService{
+			SelfServiceDescription: selfServiceData["self_service_description"].(string),
+			FeatureOnMainPage:      selfServiceData["feature_on_main_page"].(bool),
+			Notification:           selfServiceData["notification"].(bool),
+			NotificationSubject:    selfServiceData["notification_subject"].(string),
+			NotificationMessage:    selfServiceData["notification_message"].(string),
+		}
+	}
+
+	scope, err := constructScope(d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct scope for Jamf Pro Mobile Device Application '%s': %v", resource.General.Name, err)
+	}
+	resource.General.Scope = *scope
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Device Application '%s' to XML: %v", resource.General.Name, err)
+	}
+
+	common.LogConstructedPayload(ctx, "Jamf Pro Mobile Device Application XML", string(resourceXML))
+
+	return resource, nil
+}
+
+// constructScope constructs a MobileDeviceApplicationSubsetScope object from the provided schema data.
+// Only the targets supported by the mobile device application endpoint are mapped from the shared scope schema.
+func constructScope(d *schema.ResourceData) (*jamfpro.MobileDeviceApplicationSubsetScope, error) {
+	scope := &jamfpro.MobileDeviceApplicationSubsetScope{
+		AllMobileDevices: d.Get("scope.0.all_mobile_devices").(bool),
+		AllJSSUsers:      d.Get("scope.0.all_jss_users").(bool),
+	}
+
+	var err error
+
+	// Targets
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetMobileDevice, int]("scope.0.mobile_device_ids", "ID", d, &scope.MobileDevices)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetMobileDeviceGroup, int]("scope.0.mobile_device_group_ids", "ID", d, &scope.MobileDeviceGroups)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetBuilding, int]("scope.0.building_ids", "ID", d, &scope.Buildings)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetDepartment, int]("scope.0.department_ids", "ID", d, &scope.Departments)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetJSSUser, int]("scope.0.jss_user_ids", "ID", d, &scope.JSSUsers)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetJSSUserGroup, int]("scope.0.jss_user_group_ids", "ID", d, &scope.JSSUserGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	// Limitations
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetNetworkSegment, int]("scope.0.limitations.0.network_segment_ids", "ID", d, &scope.Limitations.NetworkSegments)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetUser, string]("scope.0.limitations.0.directory_service_or_local_usernames", "Name", d, &scope.Limitations.Users)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetUserGroup, int]("scope.0.limitations.0.directory_service_usergroup_ids", "ID", d, &scope.Limitations.UserGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	// Exclusions
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetMobileDevice, int]("scope.0.exclusions.0.mobile_device_ids", "ID", d, &scope.Exclusions.MobileDevices)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetMobileDeviceGroup, int]("scope.0.exclusions.0.mobile_device_group_ids", "ID", d, &scope.Exclusions.MobileDeviceGroups)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetBuilding, int]("scope.0.exclusions.0.building_ids", "ID", d, &scope.Exclusions.Buildings)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetDepartment, int]("scope.0.exclusions.0.department_ids", "ID", d, &scope.Exclusions.Departments)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetJSSUser, int]("scope.0.exclusions.0.jss_user_ids", "ID", d, &scope.Exclusions.JSSUsers)
+	if err != nil {
+		return nil, err
+	}
+	err = sharedschemas.ExtractNestedObjectsFromSchema[jamfpro.MobileDeviceApplicationSubsetJSSUserGroup, int]("scope.0.exclusions.0.jss_user_group_ids", "ID", d, &scope.Exclusions.JSSUserGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	return scope, nil
+}
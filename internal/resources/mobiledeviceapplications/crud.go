@@ -0,0 +1,131 @@
+package mobiledeviceapplications
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// create is responsible for creating a new Jamf Pro Mobile Device Application in the remote system.
+// The classic API only returns the new object's ID at the document root, which the SDK response
+// struct does not capture, so the ID is resolved by bundle ID and version once the create has succeeded.
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	var diags diag.Diagnostics
+
+	resource, err := construct(ctx, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Mobile Device Application: %v", err))
+	}
+
+	var createdResource *jamfpro.ResourceMobileDeviceApplication
+	attempt := 0
+	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		attempt++
+
+		// A previous attempt may have timed out after Jamf Pro accepted the POST, so check
+		// for the application before sending it again to avoid creating a duplicate.
+		if attempt > 1 {
+			if existing, lookupErr := lookupCreatedApplication(client, resource); lookupErr == nil {
+				createdResource = existing
+				return nil
+			}
+		}
+
+		_, apiErr := client.CreateMobileDeviceApplication(resource)
+		if apiErr != nil {
+			return jamfclient.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create Jamf Pro Mobile Device Application '%s' after retries: %v", resource.General.Name, err))
+	}
+
+	if createdResource == nil {
+		err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+			var lookupErr error
+			createdResource, lookupErr = lookupCreatedApplication(client, resource)
+			if lookupErr != nil {
+				return retry.RetryableError(lookupErr)
+			}
+			return nil
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to resolve ID of created Jamf Pro Mobile Device Application '%s': %v", resource.General.Name, err))
+		}
+	}
+
+	d.SetId(strconv.Itoa(createdResource.General.ID))
+
+	return append(diags, readNoCleanup(ctx, d, meta)...)
+}
+
+// lookupCreatedApplication fetches the application matching the bundle ID and version of the constructed
+// resource, and checks the returned object is the one that was created. Jamf Pro permits several
+// applications to share a name, so the name is only used to confirm the match.
+func lookupCreatedApplication(client *jamfpro.Client, resource *jamfpro.ResourceMobileDeviceApplication) (*jamfpro.ResourceMobileDeviceApplication, error) {
+	app, err := client.GetMobileDeviceApplicationByAppBundleIDAndVersion(resource.General.BundleID, resource.General.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if app.General.ID == 0 || app.General.BundleID != resource.General.BundleID || app.General.Name != resource.General.Name {
+		return nil, fmt.Errorf("no mobile device application named '%s' found with bundle ID '%s' and version '%s'", resource.General.Name, resource.General.BundleID, resource.General.Version)
+	}
+
+	return app, nil
+}
+
+// read is responsible for reading the current state of a Jamf Pro Mobile Device Application from the remote system.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup bool) diag.Diagnostics {
+	return common.Read(
+		ctx,
+		d,
+		meta,
+		cleanup,
+		meta.(*jamfpro.Client).GetMobileDeviceApplicationByID,
+		updateState,
+	)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating an existing Jamf Pro Mobile Device Application on the remote system.
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return common.Update(
+		ctx,
+		d,
+		meta,
+		construct,
+		meta.(*jamfpro.Client).UpdateMobileDeviceApplicationByID,
+		readNoCleanup,
+	)
+}
+
+// delete is responsible for deleting a Jamf Pro Mobile Device Application.
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return common.Delete(
+		ctx,
+		d,
+		meta,
+		meta.(*jamfpro.Client).DeleteMobileDeviceApplicationpByID,
+	)
+}
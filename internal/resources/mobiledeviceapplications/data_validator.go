@@ -0,0 +1,101 @@
+package mobiledeviceapplications
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc orchestrates all custom diff validations.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if err := validateAppConfiguration(ctx, diff, i); err != nil {
+		return err
+	}
+
+	if err := validateUnsupportedScopeFields(ctx, diff, i); err != nil {
+		return err
+	}
+
+	if err := validateDisabledBooleans(ctx, diff, i); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateAppConfiguration ensures the managed app configuration is well formed XML.
+func validateAppConfiguration(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	resourceName := diff.Get("name").(string)
+	preferences := diff.Get("app_configuration_preferences").(string)
+
+	if preferences == "" {
+		return nil
+	}
+
+	if _, err := common.NormalizeXML(preferences); err != nil {
+		return fmt.Errorf("in 'jamfpro_mobile_device_application.%s': 'app_configuration_preferences' is not valid XML: %v", resourceName, err)
+	}
+
+	return nil
+}
+
+// validateUnsupportedScopeFields ensures scope fields from the shared mobile device scope schema that the
+// mobile device application endpoint does not support are not set, as Jamf Pro would silently drop them.
+func validateUnsupportedScopeFields(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	resourceName := diff.Get("name").(string)
+
+	unsupported := []string{
+		"scope.0.limitations.0.ibeacon_ids",
+		"scope.0.exclusions.0.network_segment_ids",
+		"scope.0.exclusions.0.ibeacon_ids",
+		"scope.0.exclusions.0.directory_service_or_local_usernames",
+		"scope.0.exclusions.0.directory_service_usergroup_ids",
+	}
+
+	for _, path := range unsupported {
+		if v, ok := diff.GetOk(path); ok && len(sharedschemas.ListOrSetItems(v)) > 0 {
+			return fmt.Errorf("in 'jamfpro_mobile_device_application.%s': '%s' is not supported for mobile device applications", resourceName, path)
+		}
+	}
+
+	return nil
+}
+
+// validateDisabledBooleans ensures an enabled application setting is not switched to false. The
+// Classic API request omits false values, so Jamf Pro would keep the setting enabled and the
+// resource would never converge.
+func validateDisabledBooleans(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	resourceName := diff.Get("name").(string)
+
+	fields := []string{
+		"make_available_after_install",
+		"deploy_as_managed_app",
+		"remove_app_when_mdm_profile_is_removed",
+		"prevent_backup_of_app_data",
+		"keep_description_and_icon_up_to_date",
+		"free",
+		"take_over_management",
+	}
+
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	for _, field := range fields {
+		configValue := rawConfig.GetAttr(field)
+		if configValue.IsNull() || !configValue.IsKnown() || configValue.True() {
+			continue
+		}
+
+		oldValue, _ := diff.GetChange(field)
+		if oldValue.(bool) {
+			return fmt.Errorf("in 'jamfpro_mobile_device_application.%s': '%s' cannot be changed from true to false through the Classic API; disable it in Jamf Pro instead", resourceName, field)
+		}
+	}
+
+	return nil
+}
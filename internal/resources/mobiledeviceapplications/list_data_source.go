@@ -0,0 +1,28 @@
+package mobiledeviceapplications
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProMobileDeviceApplicationsList provides the ID and name of every mobile device application in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProMobileDeviceApplicationsList() *schema.Resource {
+	return common.ListDataSource("mobile device application", listNames)
+}
+
+// listNames returns the ID and name of every mobile device application in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetMobileDeviceApplications()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.MobileDeviceApplications))
+	for _, item := range response.MobileDeviceApplications {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -0,0 +1,212 @@
+package mobiledeviceapplications
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProMobileDeviceApplications defines the schema and CRUD operations for managing Jamf Pro Mobile Device Applications in Terraform.
+func ResourceJamfProMobileDeviceApplications() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: mainCustomDiffFunc,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the mobile device application.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the mobile device application.",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the mobile device application.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the mobile device application.",
+			},
+			"bundle_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The bundle identifier of the mobile device application, e.g. 'com.apple.Pages'.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The version of the mobile device application.",
+			},
+			"internal_app": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the application is an in-house (enterprise) application.",
+			},
+			"os_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "iOS",
+				Description:  "The operating system the application targets. (iOS or tvOS).",
+				ValidateFunc: validation.StringInSlice([]string{"iOS", "tvOS"}, false),
+			},
+			"site_id":     sharedschemas.GetSharedSchemaSite(),
+			"category_id": sharedschemas.GetSharedSchemaCategory(),
+			"app_store_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The App Store URL of the application, e.g. 'https://apps.apple.com/us/app/pages/id361309726'.",
+			},
+			"itunes_country_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "US",
+				Description: "The App Store country or region used to look up the application.",
+			},
+			"deployment_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Make Available in Self Service",
+				Description:  "The distribution method for the application. ('Install Automatically/Prompt Users to Install' or 'Make Available in Self Service').",
+				ValidateFunc: validation.StringInSlice([]string{"Install Automatically/Prompt Users to Install", "Make Available in Self Service"}, false),
+			},
+			// The SDK omits false booleans from the request, so these are Computed rather than
+			// defaulted and switching one off from true is rejected in validateDisabledBooleans.
+			"make_available_after_install": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the application remains available in Self Service after installation.",
+			},
+			"deploy_as_managed_app": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the application is deployed as a managed app.",
+			},
+			"remove_app_when_mdm_profile_is_removed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the application is removed when the MDM profile is removed.",
+			},
+			"prevent_backup_of_app_data": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to prevent backup of app data.",
+			},
+			"keep_description_and_icon_up_to_date": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to automatically keep the description and icon in sync with the App Store.",
+			},
+			"free": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the application is free.",
+			},
+			"take_over_management": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether to take over management of the application if it was installed by the user.",
+			},
+			"vpp": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Volume Purchasing (VPP) license assignment settings for the application.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assign_vpp_device_based_licenses": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to assign device-based VPP licenses.",
+						},
+						"vpp_admin_account_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     -1,
+							Description: "The ID of the Volume Purchasing location used to assign licenses. Use -1 if not required.",
+						},
+					},
+				},
+			},
+			"app_configuration_preferences": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: common.DiffSuppressXML,
+				Description:      "The managed app configuration as raw plist XML, e.g. the output of file(\"appconfig.plist\"). Whitespace and formatting differences are ignored.",
+			},
+			"self_service": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Self Service settings for the application.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"self_service_description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The description to display in Self Service.",
+						},
+						"feature_on_main_page": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to feature the application on the main page of Self Service.",
+						},
+						"notification": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to send a notification when the application is made available.",
+						},
+						"notification_subject": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The subject of the notification.",
+						},
+						"notification_message": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The message of the notification.",
+						},
+					},
+				},
+			},
+			"scope": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Required:    true,
+				Description: "The scope of the mobile device application.",
+				Elem:        sharedschemas.GetSharedMobileDeviceSchemaScope(),
+			},
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package mobiledeviceapplications
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Mobile Device Application information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceApplication) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	general := resp.General
+
+	resourceData := map[string]interface{}{
+		"id":                                     strconv.Itoa(general.ID),
+		"name":                                   general.Name,
+		"display_name":                           general.DisplayName,
+		"description":                            general.Description,
+		"bundle_id":                              general.BundleID,
+		"version":                                general.Version,
+		"internal_app":                           general.InternalApp,
+		"os_type":                                general.OsType,
+		"app_store_url":                          general.ITunesStoreURL,
+		"itunes_country_region":                  general.ITunesCountryRegion,
+		"deployment_type":                        general.DeploymentType,
+		"make_available_after_install":           general.MakeAvailableAfterInstall,
+		"deploy_as_managed_app":                  general.DeployAsManagedApp,
+		"remove_app_when_mdm_profile_is_removed": general.RemoveAppWhenMDMProfileIsRemoved,
+		"prevent_backup_of_app_data":             general.PreventBackupOfAppData,
+		"keep_description_and_icon_up_to_date":   general.KeepDescriptionAndIconUpToDate,
+		"free":                                   general.Free,
+		"take_over_management":                   general.TakeOverManagement,
+		"app_configuration_preferences":          general.AppConfiguration.Preferences,
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	if general.Site != nil {
+		d.Set("site_id", general.Site.ID)
+	}
+
+	if general.Category != nil {
+		d.Set("category_id", general.Category.ID)
+	}
+
+	vpp := map[string]interface{}{
+		"assign_vpp_device_based_licenses": general.VPP.AssignVPPDeviceBasedLicenses,
+		"vpp_admin_account_id":             general.VPP.VPPAdminAccountID,
+	}
+	if err := d.Set("vpp", []interface{}{vpp}); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	selfService := map[string]interface{}{
+		"self_service_description": general.SelfService.SelfServiceDescription,
+		"feature_on_main_page":     general.SelfService.FeatureOnMainPage,
+		"notification":             general.SelfService.Notification,
+		"notification_subject":     general.SelfService.NotificationSubject,
+		"notification_message":     general.SelfService.NotificationMessage,
+	}
+	if err := d.Set("self_service", []interface{}{selfService}); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("scope", []interface{}{setScope(general.Scope)}); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// setScope converts the mobile device application scope into a format suitable for setting in the Terraform state.
+func setScope(scope jamfpro.MobileDeviceApplicationSubsetScope) map[string]interface{} {
+	scopeData := map[string]interface{}{
+		"all_mobile_devices":      scope.AllMobileDevices,
+		"all_jss_users":           scope.AllJSSUsers,
+		"mobile_device_ids":       flattenAndSortScopeEntityIds(scope.MobileDevices),
+		"mobile_device_group_ids": flattenAndSortScopeEntityIds(scope.MobileDeviceGroups),
+		"building_ids":            flattenAndSortScopeEntityIds(scope.Buildings),
+		"department_ids":          flattenAndSortScopeEntityIds(scope.Departments),
+		"jss_user_ids":            flattenAndSortScopeEntityIds(scope.JSSUsers),
+		"jss_user_group_ids":      flattenAndSortScopeEntityIds(scope.JSSUserGroups),
+	}
+
+	limitations := map[string]interface{}{}
+	if ids := flattenAndSortScopeEntityIds(scope.Limitations.NetworkSegments); len(ids) > 0 {
+		limitations["network_segment_ids"] = ids
+	}
+	if ids := flattenAndSortScopeEntityIds(scope.Limitations.UserGroups); len(ids) > 0 {
+		limitations["directory_service_usergroup_ids"] = ids
+	}
+	if len(scope.Limitations.Users) > 0 {
+		var names []string
+		for _, user := range scope.Limitations.Users {
+			if user.Name != "" {
+				names = append(names, user.Name)
+			}
+		}
+		sort.Strings(names)
+		limitations["directory_service_or_local_usernames"] = names
+	}
+	if len(limitations) > 0 {
+		scopeData["limitations"] = []interface{}{limitations}
+	}
+
+	exclusions := map[string]interface{}{}
+	if ids := flattenAndSortScopeEntityIds(scope.Exclusions.MobileDevices); len(ids) > 0 {
+		exclusions["mobile_device_ids"] = ids
+	}
+	if ids := flattenAndSortScopeEntityIds(scope.Exclusions.MobileDeviceGroups); len(ids) > 0 {
+		exclusions["mobile_device_group_ids"] = ids
+	}
+	if ids := flattenAndSortScopeEntityIds(scope.Exclusions.Buildings); len(ids) > 0 {
+		exclusions["building_ids"] = ids
+	}
+	if ids := flattenAndSortScopeEntityIds(scope.Exclusions.Departments); len(ids) > 0 {
+		exclusions["department_ids"] = ids
+	}
+	if ids := flattenAndSortScopeEntityIds(scope.Exclusions.JSSUsers); len(ids) > 0 {
+		exclusions["jss_user_ids"] = ids
+	}
+	if ids := flattenAndSortScopeEntityIds(scope.Exclusions.JSSUserGroups); len(ids) > 0 {
+		exclusions["jss_user_group_ids"] = ids
+	}
+	if len(exclusions) > 0 {
+		scopeData["exclusions"] = []interface{}{exclusions}
+	}
+
+	return scopeData
+}
+
+// flattenAndSortScopeEntityIds extracts the non-zero ID field from a slice of scope entity structs and returns them sorted.
+func flattenAndSortScopeEntityIds[T any](entities []T) []int {
+	var ids []int
+	for _, entity := range entities {
+		idField := reflect.ValueOf(entity).FieldByName("ID")
+		if idField.IsValid() && idField.Kind() == reflect.Int && idField.Int() != 0 {
+			ids = append(ids, int(idField.Int()))
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
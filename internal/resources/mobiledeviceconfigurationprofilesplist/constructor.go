@@ -2,18 +2,19 @@
 package mobiledeviceconfigurationprofilesplist
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"html"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProMobileDeviceConfigurationProfile constructs a ResourceMobileDeviceConfigurationProfile object from the provided schema data.
-func constructJamfProMobileDeviceConfigurationProfilePlist(d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceConfigurationProfile, error) {
+func constructJamfProMobileDeviceConfigurationProfilePlist(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceConfigurationProfile, error) {
 
 	profile := &jamfpro.ResourceMobileDeviceConfigurationProfile{
 		General: jamfpro.MobileDeviceConfigurationProfileSubsetGeneral{
@@ -43,7 +44,7 @@ func constructJamfProMobileDeviceConfigurationProfilePlist(d *schema.ResourceDat
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Device Configuration Profile '%s' to XML: %v", profile.General.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Mobile Device Configuration Profile XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Mobile Device Configuration Profile XML", string(resourceXML))
 
 	return profile, nil
 }
@@ -56,22 +57,22 @@ func constructMobileDeviceConfigurationProfileSubsetScope(data map[string]interf
 	}
 
 	if mobileDeviceIDs, ok := data["mobile_device_ids"]; ok {
-		scope.MobileDevices = constructMobileDevices(mobileDeviceIDs.([]interface{}))
+		scope.MobileDevices = constructMobileDevices(sharedschemas.ListOrSetItems(mobileDeviceIDs))
 	}
 	if mobileDeviceGroupIDs, ok := data["mobile_device_group_ids"]; ok {
-		scope.MobileDeviceGroups = constructScopeEntitiesFromIds(mobileDeviceGroupIDs.([]interface{}))
+		scope.MobileDeviceGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(mobileDeviceGroupIDs))
 	}
 	if buildingIDs, ok := data["building_ids"]; ok {
-		scope.Buildings = constructScopeEntitiesFromIds(buildingIDs.([]interface{}))
+		scope.Buildings = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(buildingIDs))
 	}
 	if departmentIDs, ok := data["department_ids"]; ok {
-		scope.Departments = constructScopeEntitiesFromIds(departmentIDs.([]interface{}))
+		scope.Departments = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(departmentIDs))
 	}
 	if jssUserIDs, ok := data["jss_user_ids"]; ok {
-		scope.JSSUsers = constructScopeEntitiesFromIds(jssUserIDs.([]interface{}))
+		scope.JSSUsers = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(jssUserIDs))
 	}
 	if jssUserGroupIDs, ok := data["jss_user_group_ids"]; ok {
-		scope.JSSUserGroups = constructScopeEntitiesFromIds(jssUserGroupIDs.([]interface{}))
+		scope.JSSUserGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(jssUserGroupIDs))
 	}
 
 	// Handle Limitations
@@ -81,8 +82,7 @@ func constructMobileDeviceConfigurationProfileSubsetScope(data map[string]interf
 	}
 
 	// Handle Exclusions
-	if exclusions, ok := data["exclusions"]; ok && len(exclusions.([]interface{})) > 0 {
-		exclusionData := exclusions.([]interface{})[0].(map[string]interface{})
+	if exclusionData, ok := sharedschemas.GetScopeExclusions(data); ok {
 		scope.Exclusions = constructExclusions(exclusionData)
 	}
 
@@ -94,16 +94,16 @@ func constructLimitations(data map[string]interface{}) jamfpro.MobileDeviceConfi
 	limitations := jamfpro.MobileDeviceConfigurationProfileSubsetLimitation{}
 
 	if userNames, ok := data["directory_service_or_local_usernames"]; ok {
-		limitations.Users = constructScopeEntitiesFromIdsFromNames(userNames.([]interface{}))
+		limitations.Users = constructScopeEntitiesFromIdsFromNames(sharedschemas.ListOrSetItems(userNames))
 	}
 	if userGroupIDs, ok := data["user_group_ids"]; ok {
-		limitations.UserGroups = constructScopeEntitiesFromIds(userGroupIDs.([]interface{}))
+		limitations.UserGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(userGroupIDs))
 	}
 	if networkSegmentIDs, ok := data["network_segment_ids"]; ok {
-		limitations.NetworkSegments = constructNetworkSegments(networkSegmentIDs.([]interface{}))
+		limitations.NetworkSegments = constructNetworkSegments(sharedschemas.ListOrSetItems(networkSegmentIDs))
 	}
 	if ibeaconIDs, ok := data["ibeacon_ids"]; ok {
-		limitations.Ibeacons = constructScopeEntitiesFromIds(ibeaconIDs.([]interface{}))
+		limitations.Ibeacons = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(ibeaconIDs))
 	}
 
 	return limitations
@@ -114,34 +114,34 @@ func constructExclusions(data map[string]interface{}) jamfpro.MobileDeviceConfig
 	exclusions := jamfpro.MobileDeviceConfigurationProfileSubsetExclusion{}
 
 	if mobileDeviceIDs, ok := data["mobile_device_ids"]; ok {
-		exclusions.MobileDevices = constructMobileDevices(mobileDeviceIDs.([]interface{}))
+		exclusions.MobileDevices = constructMobileDevices(sharedschemas.ListOrSetItems(mobileDeviceIDs))
 	}
 	if mobileDeviceGroupIDs, ok := data["mobile_device_group_ids"]; ok {
-		exclusions.MobileDeviceGroups = constructScopeEntitiesFromIds(mobileDeviceGroupIDs.([]interface{}))
+		exclusions.MobileDeviceGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(mobileDeviceGroupIDs))
 	}
 	if userIDs, ok := data["user_ids"]; ok {
-		exclusions.Users = constructScopeEntitiesFromIds(userIDs.([]interface{}))
+		exclusions.Users = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(userIDs))
 	}
 	if userGroupIDs, ok := data["user_group_ids"]; ok {
-		exclusions.UserGroups = constructScopeEntitiesFromIds(userGroupIDs.([]interface{}))
+		exclusions.UserGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(userGroupIDs))
 	}
 	if buildingIDs, ok := data["building_ids"]; ok {
-		exclusions.Buildings = constructScopeEntitiesFromIds(buildingIDs.([]interface{}))
+		exclusions.Buildings = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(buildingIDs))
 	}
 	if departmentIDs, ok := data["department_ids"]; ok {
-		exclusions.Departments = constructScopeEntitiesFromIds(departmentIDs.([]interface{}))
+		exclusions.Departments = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(departmentIDs))
 	}
 	if networkSegmentIDs, ok := data["network_segment_ids"]; ok {
-		exclusions.NetworkSegments = constructNetworkSegments(networkSegmentIDs.([]interface{}))
+		exclusions.NetworkSegments = constructNetworkSegments(sharedschemas.ListOrSetItems(networkSegmentIDs))
 	}
 	if ibeaconIDs, ok := data["ibeacon_ids"]; ok {
-		exclusions.IBeacons = constructScopeEntitiesFromIds(ibeaconIDs.([]interface{}))
+		exclusions.IBeacons = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(ibeaconIDs))
 	}
 	if jssUserIDs, ok := data["jss_user_ids"]; ok {
-		exclusions.JSSUsers = constructScopeEntitiesFromIds(jssUserIDs.([]interface{}))
+		exclusions.JSSUsers = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(jssUserIDs))
 	}
 	if jssUserGroupIDs, ok := data["jss_user_group_ids"]; ok {
-		exclusions.JSSUserGroups = constructScopeEntitiesFromIds(jssUserGroupIDs.([]interface{}))
+		exclusions.JSSUserGroups = constructScopeEntitiesFromIds(sharedschemas.ListOrSetItems(jssUserGroupIDs))
 	}
 
 	return exclusions
@@ -186,8 +186,8 @@ func getNestedMap(data map[string]interface{}, key string) map[string]interface{
 // getSlice retrieves a slice from the provided data.
 func getSlice(data map[string]interface{}, key string) []interface{} {
 	if v, ok := data[key]; ok {
-		if slice, ok := v.([]interface{}); ok {
-			return slice
+		if items := sharedschemas.ListOrSetItems(v); items != nil {
+			return items
 		}
 	}
 	return []interface{}{}
@@ -6,7 +6,9 @@ import (
 	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +24,7 @@ func resourceJamfProMobileDeviceConfigurationProfilePlistCreate(ctx context.Cont
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	resource, err := constructJamfProMobileDeviceConfigurationProfilePlist(d)
+	resource, err := constructJamfProMobileDeviceConfigurationProfilePlist(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Mobile Device Configuration Profile: %v", err))
 	}
@@ -89,11 +91,15 @@ func resourceJamfProMobileDeviceConfigurationProfilePlistUpdate(ctx context.Cont
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	resource, err := constructJamfProMobileDeviceConfigurationProfilePlist(d)
+	resource, err := constructJamfProMobileDeviceConfigurationProfilePlist(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Mobile Device Configuration Profile for update: %v", err))
 	}
 
+	if sharedschemas.RedeployTriggered(d) {
+		resource.General.RedeployOnUpdate = sharedschemas.RedeployToAll
+	}
+
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
 		_, apiErr := client.UpdateMobileDeviceConfigurationProfileByID(resourceID, resource)
 		if apiErr != nil {
@@ -118,6 +124,9 @@ func resourceJamfProMobileDeviceConfigurationProfilePlistDelete(ctx context.Cont
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		apiErr := client.DeleteMobileDeviceConfigurationProfileByID(resourceID)
 		if apiErr != nil {
+			if !common.NameFallbackEnabled(client) {
+				return jamfclient.RetryError(apiErr)
+			}
 			resourceName := d.Get("name").(string)
 			apiErrByName := client.DeleteMobileDeviceConfigurationProfileByName(resourceName)
 			if apiErrByName != nil {
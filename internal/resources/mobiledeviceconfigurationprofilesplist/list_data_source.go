@@ -0,0 +1,28 @@
+package mobiledeviceconfigurationprofilesplist
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProMobileDeviceConfigurationProfilesPlistList provides the ID and name of every mobile device configuration profile in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProMobileDeviceConfigurationProfilesPlistList() *schema.Resource {
+	return common.ListDataSource("mobile device configuration profile", listNames)
+}
+
+// listNames returns the ID and name of every mobile device configuration profile in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetMobileDeviceConfigurationProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.ConfigurationProfiles))
+	for _, item := range response.ConfigurationProfiles {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -76,6 +76,7 @@ func ResourceJamfProMobileDeviceConfigurationProfilesPlist() *schema.Resource {
 					return warns, errs
 				},
 			},
+			"redeploy_on_trigger": sharedschemas.GetSharedSchemaRedeployOnTrigger(),
 			"redeploy_on_update": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -115,6 +116,8 @@ func ResourceJamfProMobileDeviceConfigurationProfilesPlist() *schema.Resource {
 				Description: "Validates plist payload XML. Turn off to force malformed XML confguration. Required when the configuration profile is a non Jamf Pro source, e.g iMazing. Removing this may cause unexpected stating behaviour.",
 			},
 			// Scope
+			"targeted_group_count": sharedschemas.GetSharedSchemaTargetedGroupCount(),
+			"excluded_group_count": sharedschemas.GetSharedSchemaExcludedGroupCount(),
 			"scope": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
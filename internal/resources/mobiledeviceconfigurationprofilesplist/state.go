@@ -6,6 +6,7 @@ import (
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/configurationprofiles/plist"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -48,12 +49,14 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceConfi
 	d.Set("category_id", resp.General.Category.ID)
 
 	// Preparing and setting scope data
-	if scopeData, err := setScope(resp); err != nil {
+	if scopeData, err := setScope(d, resp); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	} else if err := d.Set("scope", []interface{}{scopeData}); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
 
+	diags = append(diags, sharedschemas.StateScopeGroupCounts(d, len(resp.Scope.MobileDeviceGroups), len(resp.Scope.Exclusions.MobileDeviceGroups))...)
+
 	// Update the resp data
 	for k, v := range resourceData {
 		if err := d.Set(k, v); err != nil {
@@ -65,7 +68,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceConfi
 }
 
 // setScope converts the scope structure into a format suitable for setting in the Terraform state.
-func setScope(resp *jamfpro.ResourceMobileDeviceConfigurationProfile) (map[string]interface{}, error) {
+func setScope(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceConfigurationProfile) (map[string]interface{}, error) {
 	scopeData := map[string]interface{}{
 		"all_mobile_devices": resp.Scope.AllMobileDevices,
 		"all_jss_users":      resp.Scope.AllJSSUsers,
@@ -89,7 +92,7 @@ func setScope(resp *jamfpro.ResourceMobileDeviceConfigurationProfile) (map[strin
 	}
 
 	// Gather exclusions
-	exclusionsData, err := setExclusions(resp.Scope.Exclusions)
+	exclusionsData, err := setExclusions(d, resp.Scope.Exclusions)
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +143,7 @@ func setLimitations(limitations jamfpro.MobileDeviceConfigurationProfileSubsetLi
 }
 
 // setExclusions collects and formats exclusion data for the Terraform state.
-func setExclusions(exclusions jamfpro.MobileDeviceConfigurationProfileSubsetExclusion) ([]map[string]interface{}, error) {
+func setExclusions(d *schema.ResourceData, exclusions jamfpro.MobileDeviceConfigurationProfileSubsetExclusion) ([]map[string]interface{}, error) {
 	result := map[string]interface{}{}
 
 	if len(exclusions.MobileDevices) > 0 {
@@ -213,11 +216,7 @@ func setExclusions(exclusions jamfpro.MobileDeviceConfigurationProfileSubsetExcl
 		}
 	}
 
-	if len(result) == 0 {
-		return nil, nil
-	}
-
-	return []map[string]interface{}{result}, nil
+	return sharedschemas.StateScopeExclusions(d, result), nil
 }
 
 // helper functions
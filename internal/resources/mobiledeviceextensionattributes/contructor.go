@@ -1,16 +1,17 @@
 package mobiledeviceextensionattributes
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // construct builds a ResourceMobileExtensionAttribute object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceMobileExtensionAttribute, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMobileExtensionAttribute, error) {
 	resource := &jamfpro.ResourceMobileExtensionAttribute{
 		Name:             d.Get("name").(string),
 		Description:      d.Get("description").(string),
@@ -51,7 +52,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceMobileExtensionAttribut
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Device Extension Attribute to JSON: %v", err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Mobile Device Extension Attribute JSON:\n%s\n", string(resourceJSON))
+	common.LogConstructedPayload(ctx, "Jamf Pro Mobile Device Extension Attribute JSON", string(resourceJSON))
 
 	return resource, nil
 }
@@ -58,6 +58,10 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 // delete is responsible for deleting a Jamf Pro MobileDevice Extension Attribute.
 
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := common.CheckDeletionAllowed(d); diags.HasError() {
+		return diags
+	}
+
 	return common.Delete(
 		ctx,
 		d,
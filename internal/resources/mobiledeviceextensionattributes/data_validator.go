@@ -1,9 +1,13 @@
 package mobiledeviceextensionattributes
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // ValidateInputType ensures that the appropriate fields are set based on the input type
@@ -28,3 +32,47 @@ func ValidateInputType(resource *jamfpro.ResourceMobileExtensionAttribute) error
 
 	return nil
 }
+
+// mainCustomDiffFunc performs plan-time validation of the Jamf Pro Mobile Device Extension Attribute resource.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validatePopupChoices(diff); err != nil {
+		return err
+	}
+
+	return common.ValidateUniqueName(diff, meta, "mobile device extension attribute", listNames)
+}
+
+// validatePopupChoices checks that every pop-up menu choice can be stored as the selected data_type.
+func validatePopupChoices(diff *schema.ResourceDiff) error {
+	// Choices built from other resources may be partly unknown at plan time; those are checked at apply.
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !diff.NewValueKnown("data_type") || !rawConfig.GetAttr("input_type").IsWhollyKnown() {
+		return nil
+	}
+
+	dataType := diff.Get("data_type").(string)
+	choices, _ := diff.Get("input_type.0.popup_choices").([]interface{})
+
+	for i, v := range choices {
+		choice, _ := v.(string)
+		if err := common.ValidateExtensionAttributeChoice(dataType, choice); err != nil {
+			return fmt.Errorf("input_type.0.popup_choices.%d: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+// listNames returns the ID and name of every mobile device extension attribute in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetMobileExtensionAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.MobileDeviceExtensionAttribute))
+	for _, item := range response.MobileDeviceExtensionAttribute {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -3,6 +3,7 @@ package mobiledeviceextensionattributes
 import (
 	"reflect"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -35,3 +36,13 @@ func suppressPopupChoicesDiff(k, old, new string, d *schema.ResourceData) bool {
 
 	return reflect.DeepEqual(oldStrings, newStrings)
 }
+
+// suppressInventoryDisplayDiff ignores the difference between the two spellings of an inventory section, such as
+// USER_AND_LOCATION in configuration and "User and Location" as returned by Jamf Pro.
+func suppressInventoryDisplayDiff(k, old, new string, d *schema.ResourceData) bool {
+	normalize := func(section string) string {
+		return strings.ToUpper(strings.ReplaceAll(section, " ", "_"))
+	}
+
+	return normalize(old) == normalize(new)
+}
@@ -0,0 +1,11 @@
+package mobiledeviceextensionattributes
+
+import (
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProMobileDeviceExtensionAttributesList provides the ID and name of every mobile device extension attribute in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProMobileDeviceExtensionAttributesList() *schema.Resource {
+	return common.ListDataSource("mobile device extension attribute", listNames)
+}
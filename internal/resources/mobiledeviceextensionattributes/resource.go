@@ -4,6 +4,7 @@ package mobiledeviceextensionattributes
 import (
 	"time"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -22,6 +23,7 @@ func ResourceJamfProMobileDeviceExtensionAttributes() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
+		CustomizeDiff: mainCustomDiffFunc,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -36,6 +38,7 @@ func ResourceJamfProMobileDeviceExtensionAttributes() *schema.Resource {
 				Required:    true,
 				Description: "The unique name of the Jamf Pro mobiledevice extension attribute.",
 			},
+			"allow_deletion": sharedschemas.GetSharedSchemaAllowDeletion(),
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -44,14 +47,16 @@ func ResourceJamfProMobileDeviceExtensionAttributes() *schema.Resource {
 			"data_type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Data type of the mobile device extension attribute. Can be String, Integer, or Date.",
+				ForceNew:     true,
+				Description:  "Data type of the mobile device extension attribute. Can be String, Integer, or Date. Changing this replaces the extension attribute, which deletes every value already collected for it from mobile device inventory.",
 				ValidateFunc: validation.StringInSlice([]string{"String", "Integer", "Date"}, false),
 			},
 			"inventory_display": {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Category in which to display the extension attribute in Jamf Pro.",
-				ValidateFunc: validation.StringInSlice([]string{"GENERAL", "HARDWARE", "USER_AND_LOCATION", "PURCHASING", "EXTENSION_ATTRIBUTES", "General", "Hardware", "User and Location", "Purchasing", "Extension Attributes"}, false),
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Category in which to display the extension attribute in Jamf Pro. Either form of a section name, such as USER_AND_LOCATION or User and Location, may be used.",
+				ValidateFunc:     validation.StringInSlice([]string{"GENERAL", "HARDWARE", "USER_AND_LOCATION", "PURCHASING", "EXTENSION_ATTRIBUTES", "General", "Hardware", "User and Location", "Purchasing", "Extension Attributes"}, false),
+				DiffSuppressFunc: suppressInventoryDisplayDiff,
 			},
 			"input_type": {
 				Type:     schema.TypeList,
@@ -62,7 +67,8 @@ func ResourceJamfProMobileDeviceExtensionAttributes() *schema.Resource {
 						"type": {
 							Type:         schema.TypeString,
 							Required:     true,
-							Description:  "Input type for the Extension Attribute.",
+							ForceNew:     true,
+							Description:  "Input type for the Extension Attribute. Changing this replaces the extension attribute and discards its collected inventory values.",
 							ValidateFunc: validation.StringInSlice([]string{"Text Field", "Pop-up Menu"}, false),
 						},
 						"popup_choices": {
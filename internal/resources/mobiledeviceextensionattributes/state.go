@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -15,7 +16,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileExtensionAt
 	if err := d.Set("id", strconv.Itoa(resp.ID)); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("name", resp.Name); err != nil {
+	if err := d.Set("name", common.DecodeName(resp.Name)); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("description", resp.Description); err != nil {
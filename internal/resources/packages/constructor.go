@@ -2,6 +2,7 @@
 package packages
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -20,7 +22,7 @@ import (
 // It extracts the filename from the full path provided in the schema and uses it for the FileName field.
 // If the full path is a URL, it downloads the file and uses the downloaded file path.
 // The function returns the constructed ResourcePackage, the local file path, and an error if any.
-func construct(d *schema.ResourceData) (*jamfpro.ResourcePackage, string, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourcePackage, string, error) {
 	fullPath := d.Get("package_file_source").(string)
 	var fileName string
 	var localFilePath string
@@ -76,7 +78,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourcePackage, string, error)
 		return nil, "", fmt.Errorf("failed to marshal Jamf Pro Package '%s' to JSON: %v", resource.FileName, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Package JSON:\n%s\n", string(resourceJSON))
+	common.LogConstructedPayload(ctx, "Jamf Pro Package JSON", string(resourceJSON))
 
 	return resource, localFilePath, nil
 }
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	jamfclient "github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -28,28 +29,31 @@ func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	client := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
 
-	resource, localFilePath, err := construct(d)
+	resource, localFilePath, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Package: %v", err))
 	}
 
+	// The metadata is only created once, so that a retried upload does not leave duplicate packages behind.
+	var creationResponse *jamfpro.ResponsePackageCreatedAndUpdated
 	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
 		var apiErr error
-		var creationResponse *jamfpro.ResponsePackageCreatedAndUpdated
 
-		creationResponse, apiErr = client.CreatePackage(*resource)
-		if apiErr != nil {
-			return retry.RetryableError(apiErr)
-		}
+		if creationResponse == nil {
+			creationResponse, apiErr = client.CreatePackage(*resource)
+			if apiErr != nil {
+				return retry.RetryableError(apiErr)
+			}
 
-		log.Printf("[DEBUG] Jamf Pro Package Metadata created: %+v", creationResponse)
+			log.Printf("[DEBUG] Jamf Pro Package Metadata created: %+v", creationResponse)
+		}
 
 		fullFilePath := localFilePath
 
 		_, apiErr = client.UploadPackage(creationResponse.ID, []string{fullFilePath})
 		if apiErr != nil {
 			log.Printf("[ERROR] Failed to upload package file for package '%s': %v", creationResponse.ID, apiErr)
-			return retry.NonRetryableError(apiErr)
+			return jamfclient.RetryError(apiErr)
 		}
 
 		d.SetId(creationResponse.ID)
@@ -113,7 +117,7 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	var diags diag.Diagnostics
 	resourceID := d.Id()
 
-	resource, localFilePath, err := construct(d)
+	resource, localFilePath, err := construct(ctx, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to construct Jamf Pro Package for update: %v", err))
 	}
@@ -174,18 +178,15 @@ func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
 		apiErr := client.DeletePackageByID(resourceID)
 		if apiErr != nil {
-			resourceName := d.Get("name").(string)
-			apiErrByName := client.DeleteScriptByName(resourceName)
-			if apiErrByName != nil {
-				return retry.RetryableError(apiErrByName)
-			}
+			// The SDK has no delete by name for packages, so there is nothing to fall back to.
+			return jamfclient.RetryError(apiErr)
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Script '%s' (ID: %s) after retries: %v", d.Get("name").(string), resourceID, err))
+		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Package '%s' (ID: %s) after retries: %v", d.Get("package_name").(string), resourceID, err))
 	}
 
 	d.SetId("")
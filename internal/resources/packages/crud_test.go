@@ -0,0 +1,74 @@
+package packages
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDelete(t *testing.T) {
+	const packagePath = "/api/v1/packages/12"
+
+	cases := []struct {
+		name      string
+		responses []jamfmock.Response
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "deleted",
+			responses: []jamfmock.Response{{StatusCode: http.StatusNoContent}},
+			wantCalls: 1,
+		},
+		{
+			name:      "transient failure is retried",
+			responses: []jamfmock.Response{jamfmock.JSON(http.StatusServiceUnavailable, `{}`), {StatusCode: http.StatusNoContent}},
+			wantCalls: 2,
+		},
+		{
+			name:      "not found fails without retrying",
+			responses: []jamfmock.Response{jamfmock.NotFound()},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := jamfmock.NewServer()
+			defer server.Close()
+			server.Handle(http.MethodDelete, packagePath, tc.responses...)
+
+			client, err := server.Client()
+			if err != nil {
+				t.Fatalf("building mock client: %v", err)
+			}
+
+			d := schema.TestResourceDataRaw(t, ResourceJamfProPackages().Schema, map[string]interface{}{"package_name": "Example.pkg"})
+			d.SetId("12")
+
+			diags := delete(context.Background(), d, client)
+
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", diags, tc.wantErr)
+			}
+			if got := len(server.Requests(http.MethodDelete, packagePath)); got != tc.wantCalls {
+				t.Fatalf("got %d delete requests, want %d", got, tc.wantCalls)
+			}
+			if tc.wantErr && d.Id() != "12" {
+				t.Fatalf("got ID %q after a failed delete, want it kept in state", d.Id())
+			}
+			if !tc.wantErr && d.Id() != "" {
+				t.Fatalf("got ID %q after deleting, want it cleared", d.Id())
+			}
+
+			// A failed package delete must never remove a script that shares the package's name.
+			if got := len(server.Requests(http.MethodDelete, "/api/v1/scripts")); got != 0 {
+				t.Fatalf("got %d script delete requests, want none", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package packages
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProPackagesList provides the ID and name of every package in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProPackagesList() *schema.Resource {
+	return common.ListDataSource("package", listNames)
+}
+
+// listNames returns the ID and name of every package in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetPackages("", "")
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Results))
+	for _, item := range response.Results {
+		objects = append(objects, common.NamedObject{ID: item.ID, Name: item.PackageName})
+	}
+	return objects, nil
+}
@@ -1,13 +1,15 @@
 package policies
 
 import (
+	"context"
+
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructPolicy builds the policy object from the HCL. It's composed of several sub-objects, each with their own schema.
-func construct(d *schema.ResourceData) (*jamfpro.ResourcePolicy, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourcePolicy, error) {
 	var err error
 	resource := &jamfpro.ResourcePolicy{}
 
@@ -597,3 +599,8 @@ func constructPayloadMaintenance(d *schema.ResourceData, resource *jamfpro.Resou
 	resource.Maintenance = *outBlock
 
 }
+
+// Render returns the policy payload built from d without calling Jamf Pro.
+func Render(ctx context.Context, d *schema.ResourceData) (interface{}, error) {
+	return construct(ctx, d)
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"reflect"
 
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,14 +13,14 @@ import (
 func GetAttrsListFromHCLForPointers[NestedObjectType any, ListItemPrimitiveType any](path string, target_field string, d *schema.ResourceData, home *[]NestedObjectType) (err error) {
 	getAttr, ok := d.GetOk(path)
 
-	if len(getAttr.([]interface{})) == 0 {
+	if len(sharedschemas.ListOrSetItems(getAttr)) == 0 {
 		return nil
 	}
 
 	if ok {
 		*home = []NestedObjectType{}
 		outList := make([]NestedObjectType, 0)
-		for _, v := range getAttr.([]interface{}) {
+		for _, v := range sharedschemas.ListOrSetItems(getAttr) {
 			var newObj NestedObjectType
 			newObjReflect := reflect.ValueOf(&newObj).Elem()
 			idField := newObjReflect.FieldByName(target_field)
@@ -0,0 +1,28 @@
+package policies
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProPoliciesList provides the ID and name of every policy in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProPoliciesList() *schema.Resource {
+	return common.ListDataSource("policy", listNames)
+}
+
+// listNames returns the ID and name of every policy in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Policy))
+	for _, item := range response.Policy {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -168,6 +168,8 @@ func ResourceJamfProPolicies() *schema.Resource {
 				Description: "All payloads container",
 				Elem:        getPolicySchemaPayloads(),
 			},
+			"targeted_group_count": sharedschemas.GetSharedSchemaTargetedGroupCount(),
+			"excluded_group_count": sharedschemas.GetSharedSchemaExcludedGroupCount(),
 			"scope": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
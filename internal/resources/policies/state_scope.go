@@ -5,8 +5,10 @@ package policies
 
 import (
 	"log"
+	"reflect"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -126,93 +128,21 @@ func stateScope(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *dia
 	}
 
 	// Scope Exclusions
-	out_scope_exclusions := make([]map[string]interface{}, 0)
-	out_scope_exclusions = append(out_scope_exclusions, make(map[string]interface{}))
-	var exclusionsSet bool
-
-	// Computers
-	if resp.Scope.Exclusions.Computers != nil && len(*resp.Scope.Exclusions.Computers) > 0 {
-		var listOfIds []int
-		for _, v := range *resp.Scope.Exclusions.Computers {
-			listOfIds = append(listOfIds, v.ID)
-		}
-		out_scope_exclusions[0]["computer_ids"] = listOfIds
-		exclusionsSet = true
-	}
-
-	// Computer Groups
-	if resp.Scope.Exclusions.ComputerGroups != nil && len(*resp.Scope.Exclusions.ComputerGroups) > 0 {
-		var listOfIds []int
-		for _, v := range *resp.Scope.Exclusions.ComputerGroups {
-			listOfIds = append(listOfIds, v.ID)
-		}
-		out_scope_exclusions[0]["computer_group_ids"] = listOfIds
-		exclusionsSet = true
-	}
-
-	// Buildings
-	if resp.Scope.Exclusions.Buildings != nil && len(*resp.Scope.Exclusions.Buildings) > 0 {
-		var listOfIds []int
-		for _, v := range *resp.Scope.Exclusions.Buildings {
-			listOfIds = append(listOfIds, v.ID)
-		}
-		out_scope_exclusions[0]["building_ids"] = listOfIds
-		exclusionsSet = true
-	}
-
-	// Departments
-	if resp.Scope.Exclusions.Departments != nil && len(*resp.Scope.Exclusions.Departments) > 0 {
-		var listOfIds []int
-		for _, v := range *resp.Scope.Exclusions.Departments {
-			listOfIds = append(listOfIds, v.ID)
-		}
-		out_scope_exclusions[0]["department_ids"] = listOfIds
-		exclusionsSet = true
-	}
-
-	// Network Segments
-	if resp.Scope.Exclusions.NetworkSegments != nil && len(*resp.Scope.Exclusions.NetworkSegments) > 0 {
-		var listOfIds []int
-		for _, v := range *resp.Scope.Exclusions.NetworkSegments {
-			listOfIds = append(listOfIds, v.ID)
-		}
-		out_scope_exclusions[0]["network_segment_ids"] = listOfIds
-		exclusionsSet = true
-	}
-
-	// JSS Users
-	if resp.Scope.Exclusions.JSSUsers != nil && len(*resp.Scope.Exclusions.JSSUsers) > 0 {
-		var listOfIds []int
-		for _, v := range *resp.Scope.Exclusions.JSSUsers {
-			listOfIds = append(listOfIds, v.ID)
-		}
-		out_scope_exclusions[0]["jss_user_ids"] = listOfIds
-		exclusionsSet = true
-	}
-
-	// JSS User Groups
-	if resp.Scope.Exclusions.JSSUserGroups != nil && len(*resp.Scope.Exclusions.JSSUserGroups) > 0 {
-		var listOfIds []int
-		for _, v := range *resp.Scope.Exclusions.JSSUserGroups {
-			listOfIds = append(listOfIds, v.ID)
-		}
-		out_scope_exclusions[0]["jss_user_group_ids"] = listOfIds
-		exclusionsSet = true
-	}
-
-	// IBeacons
-	if resp.Scope.Exclusions.IBeacons != nil && len(*resp.Scope.Exclusions.IBeacons) > 0 {
-		var listOfIds []int
-		for _, v := range *resp.Scope.Exclusions.IBeacons {
-			listOfIds = append(listOfIds, v.ID)
-		}
-		out_scope_exclusions[0]["ibeacon_ids"] = listOfIds
-		exclusionsSet = true
-	}
+	exclusions := resp.Scope.Exclusions
+	exclusionsData := sharedschemas.StateScopeExclusions(d, map[string]interface{}{
+		"computer_ids":        policyScopeIDs(exclusions.Computers),
+		"computer_group_ids":  policyScopeIDs(exclusions.ComputerGroups),
+		"building_ids":        policyScopeIDs(exclusions.Buildings),
+		"department_ids":      policyScopeIDs(exclusions.Departments),
+		"network_segment_ids": policyScopeIDs(exclusions.NetworkSegments),
+		"jss_user_ids":        policyScopeIDs(exclusions.JSSUsers),
+		"jss_user_group_ids":  policyScopeIDs(exclusions.JSSUserGroups),
+		"ibeacon_ids":         policyScopeIDs(exclusions.IBeacons),
+	})
 
 	// Append Exclusions if they're set
-	if exclusionsSet {
-		out_scope[0]["exclusions"] = out_scope_exclusions
+	if exclusionsData != nil {
+		out_scope[0]["exclusions"] = exclusionsData
 	} else {
 		log.Println("No exclusions set") // TODO logging
 	}
@@ -222,4 +152,19 @@ func stateScope(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diags *dia
 	if err != nil {
 		*diags = append(*diags, diag.FromErr(err)...)
 	}
+
+	*diags = append(*diags, sharedschemas.StateScopeGroupCounts(d, len(policyScopeIDs(resp.Scope.ComputerGroups)), len(policyScopeIDs(exclusions.ComputerGroups)))...)
+}
+
+// policyScopeIDs returns the IDs of the policy scope entities, in the order Jamf Pro returned them.
+func policyScopeIDs[T any](entities *[]T) []int {
+	if entities == nil {
+		return nil
+	}
+
+	var listOfIds []int
+	for _, v := range *entities {
+		listOfIds = append(listOfIds, reflect.ValueOf(v).FieldByName("ID").Interface().(int))
+	}
+	return listOfIds
 }
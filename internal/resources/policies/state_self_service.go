@@ -29,10 +29,10 @@ func stateSelfService(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diag
 		"feature_on_main_page":            resp.SelfService.FeatureOnMainPage,
 	}
 
-	allDefault := false
+	allDefault := len(resp.SelfService.SelfServiceCategories) == 0
 	for key, value := range current {
 		if value != defaults[key] {
-			allDefault = true
+			allDefault = false
 			break
 		}
 	}
@@ -51,22 +51,47 @@ func stateSelfService(d *schema.ResourceData, resp *jamfpro.ResourcePolicy, diag
 	out_ss[0]["force_users_to_view_description"] = resp.SelfService.ForceUsersToViewDescription
 	out_ss[0]["feature_on_main_page"] = resp.SelfService.FeatureOnMainPage
 
-	out_ss[0]["self_service_category"] = make([]map[string]interface{}, 0)
-	if resp.SelfService.SelfServiceCategories != nil {
-		for _, v := range resp.SelfService.SelfServiceCategories {
-			var categoryBlock []map[string]interface{}
-			categoryItem := map[string]interface{}{
-				"id":         v.ID,
-				"display_in": v.DisplayIn,
-				"feature_in": v.FeatureIn,
-			}
-			categoryBlock = append(categoryBlock, categoryItem)
-			out_ss[0]["self_service_category"] = categoryBlock
-		}
+	categoryBlock := make([]map[string]interface{}, 0, len(resp.SelfService.SelfServiceCategories))
+	for _, v := range orderSelfServiceCategories(d, resp.SelfService.SelfServiceCategories) {
+		categoryBlock = append(categoryBlock, map[string]interface{}{
+			"id":         v.ID,
+			"display_in": v.DisplayIn,
+			"feature_in": v.FeatureIn,
+		})
 	}
+	out_ss[0]["self_service_category"] = categoryBlock
 
 	err := d.Set("self_service", out_ss)
 	if err != nil {
 		*diags = append(*diags, diag.FromErr(err)...)
 	}
 }
+
+// orderSelfServiceCategories returns categories in the order their IDs appear in the configuration, followed by
+// any categories added outside Terraform in the order Jamf Pro returned them, so a reordered response does not
+// produce a diff.
+func orderSelfServiceCategories(d *schema.ResourceData, categories []jamfpro.PolicySubsetSelfServiceCategory) []jamfpro.PolicySubsetSelfServiceCategory {
+	byID := make(map[int]jamfpro.PolicySubsetSelfServiceCategory, len(categories))
+	for _, category := range categories {
+		byID[category.ID] = category
+	}
+
+	ordered := make([]jamfpro.PolicySubsetSelfServiceCategory, 0, len(categories))
+	stated := make(map[int]bool, len(categories))
+	for _, v := range d.Get("self_service.0.self_service_category").([]interface{}) {
+		id := v.(map[string]interface{})["id"].(int)
+		if category, ok := byID[id]; ok && !stated[id] {
+			ordered = append(ordered, category)
+			stated[id] = true
+		}
+	}
+
+	for _, category := range categories {
+		if !stated[category.ID] {
+			ordered = append(ordered, category)
+			stated[category.ID] = true
+		}
+	}
+
+	return ordered
+}
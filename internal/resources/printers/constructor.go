@@ -2,16 +2,17 @@
 package printers
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProPrinter constructs a ResourcePrinter object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourcePrinter, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourcePrinter, error) {
 	resource := &jamfpro.ResourcePrinter{
 		Name:        d.Get("name").(string),
 		Category:    d.Get("category_name").(string),
@@ -33,7 +34,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourcePrinter, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Printer '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Printer XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Printer XML", string(resourceXML))
 
 	return resource, nil
 }
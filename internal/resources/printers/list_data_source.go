@@ -0,0 +1,28 @@
+package printers
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProPrintersList provides the ID and name of every printer in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProPrintersList() *schema.Resource {
+	return common.ListDataSource("printer", listNames)
+}
+
+// listNames returns the ID and name of every printer in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetPrinters()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Printer))
+	for _, item := range response.Printer {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
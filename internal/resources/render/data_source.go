@@ -0,0 +1,115 @@
+// render/data_source.go
+// This package contains a data source that renders resource payloads without sending them to Jamf Pro.
+
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Renderer pairs a resource schema with the constructor that builds its Jamf Pro payload.
+type Renderer struct {
+	Resource  func() *schema.Resource
+	Construct func(ctx context.Context, d *schema.ResourceData) (interface{}, error)
+}
+
+// DataSourceJamfProRender runs the constructor of a resource against the given attributes and returns the
+// payload the provider would send, as XML and JSON. It makes no API calls and is intended for debugging
+// payloads that fail to construct or are rejected by Jamf Pro.
+func DataSourceJamfProRender(renderers map[string]Renderer) *schema.Resource {
+	resourceTypes := make([]string, 0, len(renderers))
+	for resourceType := range renderers {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return read(ctx, d, renderers)
+		},
+		Description: "Renders the payload of a resource without applying it. Intended for debugging.",
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resourceTypes, false),
+				Description:  fmt.Sprintf("The resource to render. One of: %s.", strings.Join(resourceTypes, ", ")),
+			},
+			"config_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  "The resource's attributes as a JSON object, as produced by jsonencode() of the resource block's arguments.",
+			},
+			"xml": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The constructed payload marshaled to XML, as sent to the Classic API.",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The constructed payload marshaled to JSON, as sent to the Jamf Pro API.",
+			},
+		},
+	}
+}
+
+// read builds resource data from config_json, runs the resource's constructor and states the marshaled payload.
+func read(ctx context.Context, d *schema.ResourceData, renderers map[string]Renderer) diag.Diagnostics {
+	resourceType := d.Get("resource_type").(string)
+	renderer, ok := renderers[resourceType]
+	if !ok {
+		return diag.Errorf("resource type '%s' cannot be rendered", resourceType)
+	}
+
+	var attributes map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("config_json").(string)), &attributes); err != nil {
+		return diag.Errorf("config_json must be a JSON object: %v", err)
+	}
+
+	resource := renderer.Resource()
+	resourceData := resource.Data(nil)
+	for key, value := range attributes {
+		if _, ok := resource.Schema[key]; !ok {
+			return diag.Errorf("%s has no attribute '%s'", resourceType, key)
+		}
+		if err := resourceData.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s attribute '%s': %v", resourceType, key, err)
+		}
+	}
+
+	payload, err := renderer.Construct(ctx, resourceData)
+	if err != nil {
+		return diag.Errorf("failed to construct %s: %v", resourceType, err)
+	}
+
+	payloadXML, err := xml.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return diag.Errorf("failed to marshal %s to XML: %v", resourceType, err)
+	}
+
+	payloadJSON, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return diag.Errorf("failed to marshal %s to JSON: %v", resourceType, err)
+	}
+
+	d.SetId(resourceType)
+	if err := d.Set("xml", string(payloadXML)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("json", string(payloadJSON)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
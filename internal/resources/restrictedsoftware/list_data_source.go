@@ -0,0 +1,28 @@
+package restrictedsoftware
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProRestrictedSoftwaresList provides the ID and name of every restricted software title in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProRestrictedSoftwaresList() *schema.Resource {
+	return common.ListDataSource("restricted software title", listNames)
+}
+
+// listNames returns the ID and name of every restricted software title in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetRestrictedSoftwares()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.RestrictedSoftware))
+	for _, item := range response.RestrictedSoftware {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -0,0 +1,209 @@
+// scoperesolution_data_source.go
+package scoperesolution
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// inventorySections are the computer inventory sections needed to resolve building and department targets.
+const inventorySections = "&section=USER_AND_LOCATION"
+
+// DataSourceJamfProScopeResolution resolves a computer scope to the IDs of the computers it currently targets,
+// so the reach of a scope can be reviewed in plan output before it is attached to a policy or profile.
+func DataSourceJamfProScopeResolution() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"all_computers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the scope targets every computer.",
+			},
+			"computer_ids":       idSetSchema("The IDs of computers targeted directly."),
+			"computer_group_ids": idSetSchema("The IDs of computer groups whose members are targeted."),
+			"building_ids":       idSetSchema("The IDs of buildings whose computers are targeted."),
+			"department_ids":     idSetSchema("The IDs of departments whose computers are targeted."),
+			"exclusions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Computers removed from the targets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"computer_ids":       idSetSchema("The IDs of computers excluded directly."),
+						"computer_group_ids": idSetSchema("The IDs of computer groups whose members are excluded."),
+						"building_ids":       idSetSchema("The IDs of buildings whose computers are excluded."),
+						"department_ids":     idSetSchema("The IDs of departments whose computers are excluded."),
+					},
+				},
+			},
+			"resolved_computer_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The IDs of the computers the scope targets after exclusions, as of this read.",
+			},
+			"resolved_computer_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of computers the scope targets after exclusions.",
+			},
+		},
+	}
+}
+
+// idSetSchema returns an optional set of Jamf Pro object IDs.
+func idSetSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeInt},
+		Description: description,
+	}
+}
+
+// scopeSelection is one side of a scope: the targets or the exclusions.
+type scopeSelection struct {
+	all           bool
+	computerIDs   []interface{}
+	groupIDs      []interface{}
+	buildingIDs   map[string]bool
+	departmentIDs map[string]bool
+}
+
+// dataSourceRead resolves the targets and exclusions against Jamf Pro and states the computers that remain.
+func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	jamfClient := meta.(*jamfpro.Client)
+
+	targets := scopeSelection{
+		all:           d.Get("all_computers").(bool),
+		computerIDs:   d.Get("computer_ids").(*schema.Set).List(),
+		groupIDs:      d.Get("computer_group_ids").(*schema.Set).List(),
+		buildingIDs:   idLookup(d.Get("building_ids").(*schema.Set).List()),
+		departmentIDs: idLookup(d.Get("department_ids").(*schema.Set).List()),
+	}
+
+	var exclusions scopeSelection
+	if v, ok := d.GetOk("exclusions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		excluded := v.([]interface{})[0].(map[string]interface{})
+		exclusions = scopeSelection{
+			computerIDs:   excluded["computer_ids"].(*schema.Set).List(),
+			groupIDs:      excluded["computer_group_ids"].(*schema.Set).List(),
+			buildingIDs:   idLookup(excluded["building_ids"].(*schema.Set).List()),
+			departmentIDs: idLookup(excluded["department_ids"].(*schema.Set).List()),
+		}
+	}
+
+	var inventory []jamfpro.ResourceComputerInventory
+	if targets.needsInventory() || exclusions.needsInventory() {
+		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+			response, apiErr := jamfClient.GetComputersInventory(inventorySections)
+			if apiErr != nil {
+				return client.RetryError(apiErr)
+			}
+			inventory = response.Results
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to list Jamf Pro computer inventory to resolve the scope: %v", err))
+		}
+	}
+
+	targeted, err := resolve(ctx, d, jamfClient, targets, inventory)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	excluded, err := resolve(ctx, d, jamfClient, exclusions, inventory)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resolved := make([]int, 0, len(targeted))
+	for id := range targeted {
+		if !excluded[id] {
+			resolved = append(resolved, id)
+		}
+	}
+	sort.Ints(resolved)
+
+	d.SetId(common.HashString(fmt.Sprint(resolved)))
+	if err := d.Set("resolved_computer_ids", resolved); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("resolved_computer_count", len(resolved)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// needsInventory reports whether resolving the selection requires the full computer inventory.
+func (s scopeSelection) needsInventory() bool {
+	return s.all || len(s.buildingIDs) > 0 || len(s.departmentIDs) > 0
+}
+
+// resolve returns the IDs of the computers matched by a selection.
+func resolve(ctx context.Context, d *schema.ResourceData, jamfClient *jamfpro.Client, selection scopeSelection, inventory []jamfpro.ResourceComputerInventory) (map[int]bool, error) {
+	computers := make(map[int]bool)
+
+	for _, v := range selection.computerIDs {
+		computers[v.(int)] = true
+	}
+
+	for _, computer := range inventory {
+		if !selection.all && !selection.buildingIDs[computer.UserAndLocation.BuildingId] && !selection.departmentIDs[computer.UserAndLocation.DepartmentId] {
+			continue
+		}
+		id, err := strconv.Atoi(computer.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected computer ID '%s' in Jamf Pro inventory: %v", computer.ID, err)
+		}
+		computers[id] = true
+	}
+
+	for _, v := range selection.groupIDs {
+		groupID := strconv.Itoa(v.(int))
+
+		var group *jamfpro.ResourceComputerGroup
+		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+			var apiErr error
+			group, apiErr = jamfClient.GetComputerGroupByID(groupID)
+			if apiErr != nil {
+				return client.RetryError(apiErr)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Jamf Pro computer group (ID: %s) to resolve the scope: %v", groupID, err)
+		}
+
+		if group.Computers != nil {
+			for _, member := range *group.Computers {
+				computers[member.ID] = true
+			}
+		}
+	}
+
+	return computers, nil
+}
+
+// idLookup converts a list of integer IDs into a set keyed by the string form Jamf Pro inventory uses.
+func idLookup(ids []interface{}) map[string]bool {
+	lookup := make(map[string]bool, len(ids))
+	for _, v := range ids {
+		lookup[strconv.Itoa(v.(int))] = true
+	}
+	return lookup
+}
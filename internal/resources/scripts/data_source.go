@@ -7,13 +7,15 @@ import (
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
-
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// DataSourceJamfProScripts provides information about a specific Jamf Pro script by its ID or Name.
+// DataSourceJamfProScripts provides information about a specific Jamf Pro script by its ID or Name, including
+// its parameter labels, so that policies can reference scripts managed outside Terraform.
 func DataSourceJamfProScripts() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRead,
@@ -22,15 +24,88 @@ func DataSourceJamfProScripts() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The Jamf Pro unique identifier (ID) of the script.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The Jamf Pro unique identifier (ID) of the script. Exactly one of id or name must be set.",
 			},
 			"name": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
 				Description: "Display name for the script.",
 			},
+			"category_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the category the script is in.",
+			},
+			"info": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Information displayed to the administrator when the script is run.",
+			},
+			"notes": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Notes about the script.",
+			},
+			"os_requirements": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating system versions the script can run on.",
+			},
+			"priority": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Execution priority of the script (BEFORE, AFTER, AT_REBOOT).",
+			},
+			"script_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the script contents stored in Jamf Pro, as a hex string.",
+			},
+			"parameter4": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of script parameter 4, describing the value a policy should pass in it.",
+			},
+			"parameter5": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of script parameter 5, describing the value a policy should pass in it.",
+			},
+			"parameter6": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of script parameter 6, describing the value a policy should pass in it.",
+			},
+			"parameter7": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of script parameter 7, describing the value a policy should pass in it.",
+			},
+			"parameter8": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of script parameter 8, describing the value a policy should pass in it.",
+			},
+			"parameter9": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of script parameter 9, describing the value a policy should pass in it.",
+			},
+			"parameter10": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of script parameter 10, describing the value a policy should pass in it.",
+			},
+			"parameter11": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The label of script parameter 11, describing the value a policy should pass in it.",
+			},
 		},
 	}
 }
@@ -38,43 +113,63 @@ func DataSourceJamfProScripts() *schema.Resource {
 // dataSourceRead fetches the details of a specific Jamf Pro script
 // from Jamf Pro using either its unique Name or its Id.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Initialize API client
-	client, ok := meta.(*jamfpro.Client)
-	if !ok {
-		return diag.Errorf("error asserting meta as *client.client")
-	}
-
-	// Initialize variables
+	jamfClient := meta.(*jamfpro.Client)
 	var diags diag.Diagnostics
-	resourceID := d.Get("id").(string)
 
-	var resource *jamfpro.ResourceScript
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+
+	lookup := fmt.Sprintf("ID '%s'", id)
+	if id == "" {
+		lookup = fmt.Sprintf("name '%s'", name)
+	}
 
-	// Read operation with retry
+	var resource *jamfpro.ResourceScript
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
-		resource, apiErr = client.GetScriptByID(resourceID)
+		if id != "" {
+			resource, apiErr = jamfClient.GetScriptByID(id)
+		} else {
+			resource, apiErr = jamfClient.GetScriptByName(name)
+		}
 		if apiErr != nil {
-			// Convert any API error into a retryable error to continue retrying
-			return retry.RetryableError(apiErr)
+			return client.RetryError(apiErr)
 		}
-		// Successfully read the script, exit the retry loop
 		return nil
 	})
 
 	if err != nil {
-		// Handle the final error after all retries have been exhausted
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Script with ID '%s' after retries: %v", resourceID, err))
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Script with %s after retries: %v", lookup, err))
+	}
+
+	if resource == nil {
+		return diag.FromErr(fmt.Errorf("no Jamf Pro Script found with %s", lookup))
+	}
+
+	d.SetId(resource.ID)
+
+	resourceData := map[string]interface{}{
+		"name":            resource.Name,
+		"category_id":     resource.CategoryId,
+		"info":            resource.Info,
+		"notes":           resource.Notes,
+		"os_requirements": resource.OSRequirements,
+		"priority":        resource.Priority,
+		"script_sha256":   common.HashString(resource.ScriptContents),
+		"parameter4":      resource.Parameter4,
+		"parameter5":      resource.Parameter5,
+		"parameter6":      resource.Parameter6,
+		"parameter7":      resource.Parameter7,
+		"parameter8":      resource.Parameter8,
+		"parameter9":      resource.Parameter9,
+		"parameter10":     resource.Parameter10,
+		"parameter11":     resource.Parameter11,
 	}
 
-	// Check if resource data exists and set the Terraform state
-	if resource != nil {
-		d.SetId(resourceID) // Confirm the ID in the Terraform state
-		if err := d.Set("name", resource.Name); err != nil {
-			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Script with ID '%s': %v", resourceID, err))...)
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
 		}
-	} else {
-		d.SetId("") // Data not found, unset the ID in the Terraform state
 	}
 
 	return diags
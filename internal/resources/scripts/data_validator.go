@@ -0,0 +1,17 @@
+package scripts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mainCustomDiffFunc orchestrates all custom diff validations.
+func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// script_sha256 is read back from Jamf Pro, so mark it unknown whenever the script is changing.
+	if diff.HasChange("script_contents") {
+		return diff.SetNewComputed("script_sha256")
+	}
+
+	return nil
+}
@@ -21,6 +21,7 @@ func ResourceJamfProScripts() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Second),
 			Delete: schema.DefaultTimeout(15 * time.Second),
 		},
+		CustomizeDiff: mainCustomDiffFunc,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -67,6 +68,11 @@ func ResourceJamfProScripts() *schema.Resource {
 				Required:    true,
 				Description: "Contents of the script. Must be non-compiled and in an accepted format.",
 			},
+			"script_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the script contents stored in Jamf Pro, as a hex string. Changes when the script is edited in Terraform or in Jamf Pro.",
+			},
 			"parameter4": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -3,6 +3,7 @@ package scripts
 
 import (
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -20,6 +21,7 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceScript) diag.Diag
 		"category_id":     resp.CategoryId,
 		"priority":        resp.Priority,
 		"script_contents": resp.ScriptContents,
+		"script_sha256":   common.HashString(resp.ScriptContents),
 		"parameter4":      resp.Parameter4,
 		"parameter5":      resp.Parameter5,
 		"parameter6":      resp.Parameter6,
@@ -0,0 +1,31 @@
+package selfservicebranding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct constructs a ResourceSelfServiceBrandingDetail object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceSelfServiceBrandingDetail, error) {
+	resource := &jamfpro.ResourceSelfServiceBrandingDetail{
+		ApplicationName:       d.Get("application_name").(string),
+		BrandingName:          d.Get("branding_name").(string),
+		BrandingNameSecondary: d.Get("branding_name_secondary").(string),
+		IconId:                d.Get("icon_id").(int),
+		BrandingHeaderImageId: d.Get("branding_header_image_id").(int),
+	}
+
+	resourceJSON, err := json.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Self Service Branding '%s' to JSON: %v", resource.BrandingName, err)
+	}
+
+	common.LogConstructedPayload(ctx, "Jamf Pro Self Service Branding JSON", string(resourceJSON))
+
+	return resource, nil
+}
@@ -0,0 +1,62 @@
+package selfservicebranding
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProSelfServiceBranding defines the schema and CRUD operations for managing Jamf Pro
+// Self Service branding for macOS in Terraform.
+func ResourceJamfProSelfServiceBranding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the Self Service branding configuration.",
+			},
+			"application_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Self Service application as shown in the Dock and Finder.",
+			},
+			"branding_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The primary branding name shown in the Self Service header.",
+			},
+			"branding_name_secondary": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The secondary branding name shown beneath the primary branding name.",
+			},
+			"icon_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The ID of a previously uploaded branding image used as the Self Service icon.",
+			},
+			"branding_header_image_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The ID of a previously uploaded branding image used as the Self Service header.",
+			},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package selfservicebranding
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Self Service Branding information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceSelfServiceBrandingDetail) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceData := map[string]interface{}{
+		"application_name":         resp.ApplicationName,
+		"branding_name":            resp.BrandingName,
+		"branding_name_secondary":  resp.BrandingNameSecondary,
+		"icon_id":                  resp.IconId,
+		"branding_header_image_id": resp.BrandingHeaderImageId,
+	}
+
+	for key, val := range resourceData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -2,16 +2,17 @@
 package sites
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProSite constructs a SharedResourceSite object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.SharedResourceSite, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.SharedResourceSite, error) {
 	resource := &jamfpro.SharedResourceSite{
 		Name: d.Get("name").(string),
 	}
@@ -22,7 +23,7 @@ func construct(d *schema.ResourceData) (*jamfpro.SharedResourceSite, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Site '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Site XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Site XML", string(resourceXML))
 
 	return resource, nil
 }
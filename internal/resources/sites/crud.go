@@ -2,10 +2,13 @@ package sites
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -56,11 +59,33 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 }
 
 // delete is responsible for deleting a Jamf Pro Site.
+// Jamf Pro refuses to delete a site that still has objects assigned to it, so when the delete fails
+// the assigned objects are listed and returned instead of retrying until the timeout.
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return common.Delete(
-		ctx,
-		d,
-		meta,
-		meta.(*jamfpro.Client).DeleteSiteByID,
-	)
+	client := meta.(*jamfpro.Client)
+	var diags diag.Diagnostics
+	resourceID := d.Id()
+	resourceName := d.Get("name").(string)
+
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+		apiErr := client.DeleteSiteByID(resourceID)
+		if apiErr == nil {
+			return nil
+		}
+
+		dependents, lookupErr := getSiteDependents(client, resourceID)
+		if lookupErr == nil && len(dependents) > 0 {
+			return retry.NonRetryableError(fmt.Errorf("site is still assigned to %d object(s), reassign or remove them first:\n  %s", len(dependents), strings.Join(dependents, "\n  ")))
+		}
+
+		return retry.RetryableError(apiErr)
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete Jamf Pro Site '%s' (ID: %s): %v", resourceName, resourceID, err))
+	}
+
+	d.SetId("")
+
+	return diags
 }
@@ -4,6 +4,7 @@ package sites
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
@@ -22,41 +23,46 @@ func DataSourceJamfProSites() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The unique identifier of the Jamf Pro site.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The unique identifier of the Jamf Pro site.",
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The unique name of the Jamf Pro site.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "The unique name of the Jamf Pro site.",
 			},
 		},
 	}
 }
 
-// dataSourceRead fetches the details of a specific Jamf Pro site
-// from Jamf Pro using either its unique Name or its Id. The function prioritizes the 'name' attribute over the 'id'
-// attribute for fetching details. If neither 'name' nor 'id' is provided, it returns an error.
-// Once the details are fetched, they are set in the data source's state.
-//
-// Parameters:
-// - ctx: The context within which the function is called. It's used for timeouts and cancellation.
-// - d: The current state of the data source.
-// - meta: The meta object that can be used to retrieve the API client connection.
-//
-// Returns:
-// - diag.Diagnostics: Returns any diagnostics (errors or warnings) encountered during the function's execution.
+// dataSourceRead fetches the details of a specific Jamf Pro site from Jamf Pro using either its
+// unique Name or its Id, exactly one of which must be provided. Once the details are fetched,
+// they are set in the data source's state.
 func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*jamfpro.Client)
 
 	var diags diag.Diagnostics
 	resourceID := d.Get("id").(string)
+	resourceName := d.Get("name").(string)
+
+	lookup := fmt.Sprintf("ID '%s'", resourceID)
+	if resourceID == "" {
+		lookup = fmt.Sprintf("name '%s'", resourceName)
+	}
 
 	var resource *jamfpro.SharedResourceSite
 	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
 		var apiErr error
-		resource, apiErr = client.GetSiteByID(resourceID)
+		if resourceID != "" {
+			resource, apiErr = client.GetSiteByID(resourceID)
+		} else {
+			resource, apiErr = client.GetSiteByName(resourceName)
+		}
 		if apiErr != nil {
 			return retry.RetryableError(apiErr)
 		}
@@ -64,16 +70,17 @@ func dataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Site with ID '%s' after retries: %v", resourceID, err))
+		return diag.FromErr(fmt.Errorf("failed to read Jamf Pro Site with %s after retries: %v", lookup, err))
 	}
 
-	if resource != nil {
-		d.SetId(resourceID)
-		if err := d.Set("name", resource.Name); err != nil {
-			diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Site with ID '%s': %v", resourceID, err))...)
-		}
-	} else {
-		d.SetId("") // Data not found, unset the id in the Terraform state
+	if resource == nil {
+		d.SetId("")
+		return diags
+	}
+
+	d.SetId(strconv.Itoa(resource.ID))
+	if err := d.Set("name", resource.Name); err != nil {
+		diags = append(diags, diag.FromErr(fmt.Errorf("error setting 'name' for Jamf Pro Site with %s: %v", lookup, err))...)
 	}
 
 	return diags
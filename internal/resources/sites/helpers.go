@@ -0,0 +1,33 @@
+package sites
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+)
+
+// uriSiteObjects lists the objects assigned to a site. The SDK has no typed call for it,
+// so it is read through the SDK's paginated GET helper.
+const uriSiteObjects = "/api/v1/sites/%s/objects"
+
+// getSiteDependents returns a sorted, human readable list of the objects assigned to a site.
+func getSiteDependents(client *jamfpro.Client, siteID string) ([]string, error) {
+	resp, err := client.DoPaginatedGet(fmt.Sprintf(uriSiteObjects, siteID), 200, 0, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects assigned to Jamf Pro Site (ID: %s): %v", siteID, err)
+	}
+
+	var dependents []string
+	for _, result := range resp.Results {
+		object, ok := result.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		dependents = append(dependents, fmt.Sprintf("%v (ID: %v)", object["objectType"], object["objectId"]))
+	}
+
+	sort.Strings(dependents)
+
+	return dependents, nil
+}
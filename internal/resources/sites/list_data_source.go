@@ -0,0 +1,28 @@
+package sites
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProSitesList provides the ID and name of every site in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProSitesList() *schema.Resource {
+	return common.ListDataSource("site", listNames)
+}
+
+// listNames returns the ID and name of every site in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetSites()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Site))
+	for _, item := range response.Site {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -2,17 +2,27 @@
 package smartcomputergroups
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProSmartComputerGroup constructs a ResourceComputerGroup object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
+	criteria := d.Get("criteria").([]interface{})
+	if groups, ok := d.GetOk("criteria_group"); ok {
+		criteria = sharedschemas.CompileCriteriaGroups(groups.([]interface{}))
+	}
+
+	if err := sharedschemas.ValidateCriteriaRegex(criteria); err != nil {
+		return nil, err
+	}
+
 	resource := &jamfpro.ResourceComputerGroup{
 		Name:    d.Get("name").(string),
 		IsSmart: true,
@@ -20,8 +30,8 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
 
 	resource.Site = sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int))
 
-	if v, ok := d.GetOk("criteria"); ok {
-		resource.Criteria = constructComputerGroupSubsetContainerCriteria(v.([]interface{}))
+	if len(criteria) > 0 {
+		resource.Criteria = constructComputerGroupSubsetContainerCriteria(criteria)
 	}
 
 	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
@@ -29,7 +39,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceComputerGroup, error) {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Computer Group '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Computer Group XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Computer Group XML", string(resourceXML))
 
 	return resource, nil
 }
@@ -57,3 +67,8 @@ func constructComputerGroupSubsetContainerCriteria(criteriaList []interface{}) *
 
 	return criteria
 }
+
+// Render returns the smart computer group payload built from d without calling Jamf Pro.
+func Render(ctx context.Context, d *schema.ResourceData) (interface{}, error) {
+	return construct(ctx, d)
+}
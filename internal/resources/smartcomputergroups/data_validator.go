@@ -15,6 +15,10 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i interf
 		return err
 	}
 
+	if err := validateCriteriaGroups(ctx, diff, i); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -44,6 +48,33 @@ func validateCriteriaPriority(_ context.Context, diff *schema.ResourceDiff, _ in
 	return nil
 }
 
+// validateCriteriaGroups ensures the first criterion of each criteria_group is joined with 'and', since the group's
+// own and_or joins it to the previous group and Jamf Pro would otherwise be sent a different operator than planned.
+func validateCriteriaGroups(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	groups, ok := diff.Get("criteria_group").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for index, g := range groups {
+		group, ok := g.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		members, _ := group["criterion"].([]interface{})
+		if len(members) == 0 {
+			continue
+		}
+
+		if first, ok := members[0].(map[string]interface{}); ok && first["and_or"] == Or {
+			return fmt.Errorf("criteria_group.%d.criterion.0: and_or must be 'and' for the first criterion of a group; set the group's and_or to join it to the previous group with 'or'", index)
+		}
+	}
+
+	return nil
+}
+
 // getCriteriaOperators returns a list of criteria operators for Smart Computer Groups.
 func getCriteriaOperators() []string {
 	var out []string
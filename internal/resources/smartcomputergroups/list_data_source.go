@@ -0,0 +1,31 @@
+package smartcomputergroups
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProSmartComputerGroupsList provides the ID and name of every smart computer group in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProSmartComputerGroupsList() *schema.Resource {
+	return common.ListDataSource("smart computer group", listNames)
+}
+
+// listNames returns the ID and name of every smart computer group in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetComputerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Results))
+	for _, item := range response.Results {
+		if !item.IsSmart {
+			continue
+		}
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -69,8 +69,9 @@ func ResourceJamfProSmartComputerGroups() *schema.Resource {
 			},
 			"site_id": sharedschemas.GetSharedSchemaSite(),
 			"criteria": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"criteria_group"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -118,6 +119,57 @@ func ResourceJamfProSmartComputerGroups() *schema.Resource {
 					},
 				},
 			},
+			"criteria_group": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"criteria"},
+				Description:   "Criteria written as groups instead of a flat list with parenthesis flags. Each group of more than one criterion is enclosed in parentheses, and priorities are assigned in the order written. Groups cannot be nested.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"and_or": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      And,
+							Description:  "How this group is joined to the previous group: 'and' or 'or'. Ignored for the first group.",
+							ValidateFunc: validation.StringInSlice([]string{And, Or}, false),
+						},
+						"criterion": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "The criteria within the group.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the smart group search criteria. Can be from the Jamf built in enteries or can be an extension attribute.",
+									},
+									"and_or": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      And,
+										Description:  "How this criterion is joined to the previous criterion in the group: 'and' or 'or'. Must be 'and' for the first criterion of a group, which is joined by the group's and_or.",
+										ValidateFunc: validation.StringInSlice([]string{And, Or}, false),
+									},
+									"search_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      SearchTypeIs,
+										Description:  fmt.Sprintf("The type of smart group search operator. Allowed values are '%v'", getCriteriaOperators()),
+										ValidateFunc: validation.StringInSlice(getCriteriaOperators(), false),
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Search value for the smart group criteria to match with.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -3,6 +3,7 @@ package smartcomputergroups
 
 import (
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -20,7 +21,12 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceComputerGroup) di
 
 	d.Set("site_id", resp.Site.ID)
 
-	if resp.Criteria != nil && resp.Criteria.Criterion != nil {
+	if _, ok := d.GetOk("criteria_group"); ok {
+		groups := sharedschemas.DecompileCriteriaGroups(setComputerSmartGroupSubsetContainerCriteria(resp.Criteria))
+		if err := d.Set("criteria_group", groups); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	} else if resp.Criteria != nil && resp.Criteria.Criterion != nil {
 		criteria := setComputerSmartGroupSubsetContainerCriteria(resp.Criteria)
 		if err := d.Set("criteria", criteria); err != nil {
 			diags = append(diags, diag.FromErr(err)...)
@@ -55,5 +61,5 @@ func setComputerSmartGroupSubsetContainerCriteria(criteria *jamfpro.ComputerGrou
 		criteriaList = append(criteriaList, criterionMap)
 	}
 
-	return criteriaList
+	return sharedschemas.SortCriteriaByPriority(criteriaList)
 }
@@ -0,0 +1,66 @@
+package smartcomputergroups
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestReadSortsCriteriaByPriority reads a smart group whose criteria Jamf Pro returns out of priority order
+// and checks that state lists them by priority, so a configuration written in that order plans clean.
+func TestReadSortsCriteriaByPriority(t *testing.T) {
+	names := []string{"Operating System Version", "Model", "Department"}
+
+	server := jamfmock.NewServer()
+	defer server.Close()
+	server.Handle(http.MethodGet, "/JSSResource/computergroups/id/5", jamfmock.XML(http.StatusOK,
+		"<computer_group><id>5</id><name>Sonoma Laptops</name><is_smart>true</is_smart><site><id>-1</id><name>None</name></site>"+
+			jamfmock.ReorderedCriteria(names...)+
+			"</computer_group>"))
+
+	client, err := server.Client()
+	if err != nil {
+		t.Fatalf("building mock client: %v", err)
+	}
+
+	var criteria []interface{}
+	for i, name := range names {
+		criteria = append(criteria, map[string]interface{}{
+			"name":        name,
+			"priority":    i,
+			"and_or":      "and",
+			"search_type": "is",
+		})
+	}
+	config := map[string]interface{}{
+		"name":     "Sonoma Laptops",
+		"criteria": criteria,
+	}
+
+	resource := ResourceJamfProSmartComputerGroups()
+	d := schema.TestResourceDataRaw(t, resource.Schema, config)
+	d.SetId("5")
+
+	if diags := readWithCleanup(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+
+	for i, name := range names {
+		if got := d.Get(fmt.Sprintf("criteria.%d.name", i)).(string); got != name {
+			t.Fatalf("got criteria.%d.name %q, want %q", i, got, name)
+		}
+	}
+
+	diff, err := resource.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("planning: %v", err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Fatalf("got a diff after reading reordered criteria: %v", diff)
+	}
+}
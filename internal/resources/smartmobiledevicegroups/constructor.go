@@ -1,16 +1,22 @@
 package smartmobiledevicegroups
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProSmartMobileGroup constructs a ResourceMobileDeviceGroup object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceGroup, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceGroup, error) {
+	if err := sharedschemas.ValidateCriteriaRegex(d.Get("criteria").([]interface{})); err != nil {
+		return nil, err
+	}
+
 	resource := &jamfpro.ResourceMobileDeviceGroup{
 		Name:    d.Get("name").(string),
 		IsSmart: true,
@@ -31,7 +37,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceGroup, erro
 		return nil, fmt.Errorf("failed to marshal Jamf Pro Mobile Group '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro Mobile Device Group XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro Mobile Device Group XML", string(resourceXML))
 
 	return resource, nil
 }
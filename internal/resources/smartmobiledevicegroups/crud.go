@@ -57,6 +57,10 @@ func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.
 
 // delete is responsible for deleting a Jamf Pro Smart Mobile Group.
 func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := common.CheckDeletionAllowed(d); diags.HasError() {
+		return diags
+	}
+
 	return common.Delete(
 		ctx,
 		d,
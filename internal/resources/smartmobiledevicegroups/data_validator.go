@@ -3,6 +3,7 @@ package smartmobiledevicegroups
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,6 +15,11 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i interf
 		return err
 	}
 
+	// Validate criteria search types against each criterion's field
+	if err := validateCriteriaSearchTypes(ctx, diff, i); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -71,3 +77,95 @@ func getCriteriaOperators() []string {
 	}
 	return out
 }
+
+// Operator sets shared by mobile device criteria of the same kind.
+var (
+	textOperators    = []string{SearchTypeIs, SearchTypeIsNot, SearchTypeLike, SearchTypeNotLike, SearchTypeMatchesRegex, SearchTypeDoesNotMatch}
+	versionOperators = append(append([]string{}, textOperators...), SearchTypeGreaterThan, SearchTypeLessThan, SearchTypeGreaterThanOrEqual, SearchTypeLessThanOrEqual)
+	numberOperators  = []string{SearchTypeIs, SearchTypeIsNot, SearchTypeMoreThan, SearchTypeLessThan}
+	dateOperators    = []string{SearchTypeIs, SearchTypeIsNot, SearchTypeBeforeYYYYMMDD, SearchTypeAfterYYYYMMDD, SearchTypeMoreThanXDaysAgo, SearchTypeLessThanXDaysAgo}
+	booleanOperators = []string{SearchTypeIs, SearchTypeIsNot}
+	groupOperators   = []string{SearchTypeMemberOf, SearchTypeNotMemberOf}
+	hasOperators     = []string{SearchTypeHas, SearchTypeDoesNotHave}
+)
+
+// criterionOperators lists the search types Jamf Pro accepts for built-in mobile device criteria. Criteria not
+// listed here, such as extension attributes, are not checked.
+var criterionOperators = map[string][]string{
+	"Display Name":          textOperators,
+	"Serial Number":         textOperators,
+	"UDID":                  textOperators,
+	"Model":                 textOperators,
+	"Model Identifier":      textOperators,
+	"Username":              textOperators,
+	"Full Name":             textOperators,
+	"Email Address":         textOperators,
+	"Phone Number":          textOperators,
+	"Position":              textOperators,
+	"Department":            textOperators,
+	"Building":              textOperators,
+	"Room":                  textOperators,
+	"Asset Tag":             textOperators,
+	"IP Address":            textOperators,
+	"Wi-Fi MAC Address":     textOperators,
+	"Bluetooth MAC Address": textOperators,
+	"iOS Version":           versionOperators,
+	"OS Version":            versionOperators,
+	"OS Build":              versionOperators,
+	"Battery Level":         numberOperators,
+	"Capacity MB":           numberOperators,
+	"Available Space MB":    numberOperators,
+	"Last Inventory Update": dateOperators,
+	"Last Enrollment":       dateOperators,
+	"Last Backup":           dateOperators,
+	"Warranty Expiration":   dateOperators,
+	"Lease Expiration":      dateOperators,
+	"PO Date":               dateOperators,
+	"Supervised":            booleanOperators,
+	"Managed":               booleanOperators,
+	"Shared iPad":           booleanOperators,
+	"Mobile Device Group":   groupOperators,
+	"App Name":              hasOperators,
+	"App Identifier":        hasOperators,
+	"Profile Name":          hasOperators,
+}
+
+// validateCriteriaSearchTypes ensures each criterion's search_type is an operator Jamf Pro accepts for its field.
+func validateCriteriaSearchTypes(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	criteria, ok := diff.Get("criteria").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	resourceName, _ := diff.Get("name").(string)
+
+	for index, raw := range criteria {
+		criterion, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := criterion["name"].(string)
+		searchType, _ := criterion["search_type"].(string)
+
+		allowed, known := criterionOperators[name]
+		if !known || searchType == "" {
+			continue
+		}
+
+		valid := false
+		for _, operator := range allowed {
+			if operator == searchType {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			return fmt.Errorf("in 'jamfpro_smart_mobile_group.%s': criterion %d ('%s') has search_type '%s', which is not valid for this field. Valid search types are: '%s'",
+				resourceName, index, name, searchType, strings.Join(allowed, "', '"))
+		}
+	}
+
+	return nil
+}
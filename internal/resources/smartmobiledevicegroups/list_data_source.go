@@ -0,0 +1,31 @@
+package smartmobiledevicegroups
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProSmartMobileGroupsList provides the ID and name of every smart mobile device group in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProSmartMobileGroupsList() *schema.Resource {
+	return common.ListDataSource("smart mobile device group", listNames)
+}
+
+// listNames returns the ID and name of every smart mobile device group in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetMobileDeviceGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.MobileDeviceGroup))
+	for _, item := range response.MobileDeviceGroup {
+		if !item.IsSmart {
+			continue
+		}
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -61,7 +61,19 @@ func ResourceJamfProSmartMobileGroups() *schema.Resource {
 				Required:    true,
 				Description: "The unique name of the Jamf Pro mobile group.",
 			},
-			"site_id": sharedschemas.GetSharedSchemaSite(),
+			"allow_deletion": sharedschemas.GetSharedSchemaAllowDeletion(),
+			"site_id":        sharedschemas.GetSharedSchemaSite(),
+			"matched_device_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of mobile devices that matched the group's criteria when it was last read.",
+			},
+			"matched_device_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the mobile devices that matched the group's criteria when it was last read.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
 			"criteria": {
 				Type:     schema.TypeList,
 				Optional: true,
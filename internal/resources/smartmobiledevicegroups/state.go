@@ -1,7 +1,10 @@
 package smartmobiledevicegroups
 
 import (
+	"fmt"
+
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -10,6 +13,15 @@ import (
 func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceGroup) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	// Updates always send is_smart true, so a group converted to static in Jamf Pro would be silently converted back.
+	if !resp.IsSmart {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Mobile device group is no longer a smart group",
+			Detail:   fmt.Sprintf("Jamf Pro mobile device group '%s' (ID: %s) is now a static group. Manage it with jamfpro_static_mobile_device_group, or remove it from state and recreate it as a smart group.", resp.Name, d.Id()),
+		}}
+	}
+
 	if err := d.Set("name", resp.Name); err != nil {
 		diags = append(diags, diag.FromErr(err)...)
 	}
@@ -29,6 +41,19 @@ func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceGroup
 		}
 	}
 
+	deviceIDs := make([]int, 0, len(resp.MobileDevices))
+	for _, device := range resp.MobileDevices {
+		deviceIDs = append(deviceIDs, device.ID)
+	}
+
+	if err := d.Set("matched_device_count", len(deviceIDs)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	if err := d.Set("matched_device_ids", deviceIDs); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
 	return diags
 }
 
@@ -53,5 +78,5 @@ func setMobileSmartGroupSubsetContainerCriteria(criteria jamfpro.SharedContainer
 		criteriaList = append(criteriaList, criterionMap)
 	}
 
-	return criteriaList
+	return sharedschemas.SortCriteriaByPriority(criteriaList)
 }
@@ -0,0 +1,60 @@
+package smartmobiledevicegroups
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestReadRejectsStaticGroup(t *testing.T) {
+	const groupPath = "/JSSResource/mobiledevicegroups/id/9"
+
+	cases := []struct {
+		name    string
+		isSmart bool
+		wantErr bool
+	}{
+		{name: "smart group", isSmart: true},
+		{name: "converted to static in Jamf Pro", isSmart: false, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			isSmart := "false"
+			if tc.isSmart {
+				isSmart = "true"
+			}
+
+			server := jamfmock.NewServer()
+			defer server.Close()
+			server.Handle(http.MethodGet, groupPath, jamfmock.XML(http.StatusOK,
+				"<mobile_device_group><id>9</id><name>Shared iPads</name><is_smart>"+isSmart+"</is_smart>"+
+					"<site><id>-1</id><name>None</name></site><criteria><size>0</size></criteria></mobile_device_group>"))
+
+			client, err := server.Client()
+			if err != nil {
+				t.Fatalf("building mock client: %v", err)
+			}
+
+			d := schema.TestResourceDataRaw(t, ResourceJamfProSmartMobileGroups().Schema, map[string]interface{}{"name": "Shared iPads"})
+			d.SetId("9")
+
+			diags := readWithCleanup(context.Background(), d, client)
+
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", diags, tc.wantErr)
+			}
+			if tc.wantErr && !strings.Contains(diags[0].Summary, "no longer a smart group") {
+				t.Fatalf("got summary %q, want the smart group mismatch", diags[0].Summary)
+			}
+			// The group stays in state so that it is not recreated over the static group.
+			if d.Id() != "9" {
+				t.Fatalf("got ID %q, want the group kept in state", d.Id())
+			}
+		})
+	}
+}
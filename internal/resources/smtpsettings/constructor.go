@@ -0,0 +1,31 @@
+// smtpsettings_constructor.go
+package smtpsettings
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// smtpServerPayload adds the write-only password, which the SDK type does not carry, to the SMTP server settings.
+type smtpServerPayload struct {
+	jamfpro.ResourceSMTPServer
+	Password string `json:"password,omitempty"`
+}
+
+// construct builds the SMTP server settings from the provided schema data.
+func construct(d *schema.ResourceData) *smtpServerPayload {
+	return &smtpServerPayload{
+		ResourceSMTPServer: jamfpro.ResourceSMTPServer{
+			Enabled:                d.Get("enabled").(bool),
+			Server:                 d.Get("server").(string),
+			Port:                   d.Get("port").(int),
+			EncryptionType:         d.Get("encryption_type").(string),
+			ConnectionTimeout:      d.Get("connection_timeout").(int),
+			SenderDisplayName:      d.Get("sender_display_name").(string),
+			SenderEmailAddress:     d.Get("sender_email_address").(string),
+			RequiresAuthentication: d.Get("requires_authentication").(bool),
+			Username:               d.Get("username").(string),
+		},
+		Password: d.Get("password").(string),
+	}
+}
@@ -0,0 +1,120 @@
+package smtpsettings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/client"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// singletonID is the fixed ID of the one SMTP server configuration in Jamf Pro.
+const singletonID = "jamfpro_smtp_server_singleton"
+
+// uriSMTPServer is the Jamf Pro API endpoint for the SMTP server settings. It is called directly so the
+// password can be sent alongside the fields the SDK knows about.
+const uriSMTPServer = "/api/v1/smtp-server"
+
+// create is responsible for bringing the Jamf Pro SMTP server settings under Terraform management.
+func create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := apply(ctx, d, meta, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(singletonID)
+
+	return readNoCleanup(ctx, d, meta)
+}
+
+// read is responsible for reading the current Jamf Pro SMTP server settings.
+func read(ctx context.Context, d *schema.ResourceData, meta interface{}, cleanup bool) diag.Diagnostics {
+	client := meta.(*jamfpro.Client)
+	var diags diag.Diagnostics
+
+	d.SetId(singletonID)
+
+	var response *jamfpro.ResourceSMTPServer
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *retry.RetryError {
+		var apiErr error
+		response, apiErr = client.GetSMTPServerInformation()
+		if apiErr != nil {
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return append(diags, common.HandleResourceNotFoundError(err, d, cleanup)...)
+	}
+
+	return append(diags, updateState(d, response)...)
+}
+
+// readWithCleanup reads the resource with cleanup enabled
+func readWithCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, true)
+}
+
+// readNoCleanup reads the resource with cleanup disabled
+func readNoCleanup(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return read(ctx, d, meta, false)
+}
+
+// update is responsible for updating the Jamf Pro SMTP server settings.
+func update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := apply(ctx, d, meta, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return readNoCleanup(ctx, d, meta)
+}
+
+// apply sends the configured SMTP server settings, including the password, to Jamf Pro.
+func apply(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout string) diag.Diagnostics {
+	jamfClient := meta.(*jamfpro.Client)
+	payload := construct(d)
+
+	err := retry.RetryContext(ctx, d.Timeout(timeout), func() *retry.RetryError {
+		resp, apiErr := jamfClient.HTTP.DoRequest("PUT", uriSMTPServer, payload, nil)
+		if resp != nil && resp.Body != nil {
+			defer resp.Body.Close()
+		}
+		if apiErr != nil {
+			return client.RetryError(apiErr)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to apply Jamf Pro SMTP server settings after retries: %v", err))
+	}
+
+	return nil
+}
+
+// delete is responsible for 'deleting' the Jamf Pro SMTP server settings.
+// They are a tenant setting rather than an object, so they are only removed from the Terraform state.
+func delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "SMTP server settings removed from state only",
+		Detail:   "Jamf Pro keeps sending email with the current SMTP server settings. Set enabled = false and apply first to stop it.",
+	})
+
+	d.SetId("")
+
+	return diags
+}
+
+// importState adopts the SMTP server settings whatever ID is given on import, as there is only one set per Jamf Pro instance.
+func importState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.SetId(singletonID)
+
+	return []*schema.ResourceData{d}, nil
+}
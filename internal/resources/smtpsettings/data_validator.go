@@ -0,0 +1,24 @@
+// smtpsettings_data_validator.go
+package smtpsettings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateAuthentication requires a username and password whenever the SMTP server requires authentication.
+func validateAuthentication(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.Get("requires_authentication").(bool) {
+		return nil
+	}
+
+	for _, field := range []string{"username", "password"} {
+		if diff.NewValueKnown(field) && diff.Get(field).(string) == "" {
+			return fmt.Errorf("'%s' must be set when 'requires_authentication' is true", field)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// smtpsettings_resource.go
+package smtpsettings
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProSMTPServer defines the schema and RU operations for managing the Jamf Pro SMTP server settings in Terraform.
+func ResourceJamfProSMTPServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: validateAuthentication,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether Jamf Pro sends email through the SMTP server.",
+			},
+			"server": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The host name or IP address of the SMTP server.",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      25,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "The port of the SMTP server.",
+			},
+			"encryption_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "SSL", "TLS_1", "TLS_1_1", "TLS_1_2", "TLS_1_3"}, false),
+				Description:  "The encryption used to connect to the SMTP server: NONE, SSL, TLS_1, TLS_1_1, TLS_1_2 or TLS_1_3.",
+			},
+			"connection_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "How long Jamf Pro waits to connect to the SMTP server, in seconds.",
+			},
+			"sender_display_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name email from Jamf Pro is sent as.",
+			},
+			"sender_email_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The address email from Jamf Pro is sent from.",
+			},
+			"requires_authentication": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the SMTP server requires a username and password. Both must then be set.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The username used to authenticate with the SMTP server.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password used to authenticate with the SMTP server. Write-only: Jamf Pro never returns the password, so changes made outside Terraform are not detected.",
+			},
+		},
+	}
+}
@@ -0,0 +1,34 @@
+// smtpsettings_state.go
+package smtpsettings
+
+import (
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest SMTP server settings from the Jamf Pro API.
+// The password is never returned, so the configured value is left in state.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceSMTPServer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	smtpData := map[string]interface{}{
+		"enabled":                 resp.Enabled,
+		"server":                  resp.Server,
+		"port":                    resp.Port,
+		"encryption_type":         resp.EncryptionType,
+		"connection_timeout":      resp.ConnectionTimeout,
+		"sender_display_name":     resp.SenderDisplayName,
+		"sender_email_address":    resp.SenderEmailAddress,
+		"requires_authentication": resp.RequiresAuthentication,
+		"username":                resp.Username,
+	}
+
+	for key, val := range smtpData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,30 @@
+package softwareupdateservers
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct constructs a ResourceSoftwareUpdateServer object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceSoftwareUpdateServer, error) {
+	resource := &jamfpro.ResourceSoftwareUpdateServer{
+		Name:          d.Get("name").(string),
+		IPAddress:     d.Get("ip_address").(string),
+		Port:          d.Get("port").(int),
+		SetSystemWide: d.Get("set_system_wide").(bool),
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Software Update Server '%s' to XML: %v", resource.Name, err)
+	}
+
+	common.LogConstructedPayload(ctx, "Jamf Pro Software Update Server XML", string(resourceXML))
+
+	return resource, nil
+}
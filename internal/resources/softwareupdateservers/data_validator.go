@@ -0,0 +1,25 @@
+package softwareupdateservers
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// hostnamePattern matches an RFC 1123 hostname: dot separated labels of letters, digits and
+// hyphens that do not start or end with a hyphen.
+var hostnamePattern = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+
+// validateHostname ensures the value is a well-formed hostname.
+func validateHostname(v interface{}, k string) (warns []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if len(value) > 253 || !hostnamePattern.MatchString(value) {
+		errs = append(errs, fmt.Errorf("%q must be a valid IP address or hostname, got: %s", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,28 @@
+package softwareupdateservers
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProSoftwareUpdateServersList provides the ID and name of every software update server in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProSoftwareUpdateServersList() *schema.Resource {
+	return common.ListDataSource("software update server", listNames)
+}
+
+// listNames returns the ID and name of every software update server in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetSoftwareUpdateServers()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Servers))
+	for _, item := range response.Servers {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
@@ -0,0 +1,64 @@
+// ssosettings_constructor.go
+package ssosettings
+
+import (
+	"encoding/base64"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	metadataSourceURL  = "URL"
+	metadataSourceFile = "FILE"
+)
+
+// construct applies the configured SSO settings over current, the settings read from Jamf Pro, so that
+// Jamf Pro's generated entity ID is kept when entity_id is not configured.
+func construct(d *schema.ResourceData, current *jamfpro.ResourceSsoSettings) jamfpro.ResourceSsoSettings {
+	resource := *current
+
+	resource.SsoEnabled = d.Get("sso_enabled").(bool)
+	resource.IdpProviderType = d.Get("idp_provider_type").(string)
+	resource.OtherProviderTypeName = d.Get("other_provider_type_name").(string)
+	resource.UserMapping = d.Get("user_mapping").(string)
+	resource.UserAttributeEnabled = d.Get("user_attribute_enabled").(bool)
+	resource.UserAttributeName = d.Get("user_attribute_name").(string)
+	resource.GroupAttributeName = d.Get("group_attribute_name").(string)
+	resource.GroupRdnKey = d.Get("group_rdn_key").(string)
+	resource.SessionTimeout = d.Get("session_timeout").(int)
+	resource.TokenExpirationDisabled = d.Get("token_expiration_disabled").(bool)
+	resource.SsoBypassAllowed = d.Get("sso_bypass_allowed").(bool)
+	resource.SsoForEnrollmentEnabled = d.Get("sso_for_enrollment_enabled").(bool)
+	resource.SsoForMacOsSelfServiceEnabled = d.Get("sso_for_macos_self_service_enabled").(bool)
+	resource.EnrollmentSsoForAccountDrivenEnrollmentEnabled = d.Get("enrollment_sso_for_account_driven_enrollment_enabled").(bool)
+	resource.GroupEnrollmentAccessEnabled = d.Get("group_enrollment_access_enabled").(bool)
+	resource.GroupEnrollmentAccessName = d.Get("group_enrollment_access_name").(string)
+
+	hosts := []string{}
+	for _, host := range d.Get("enrollment_sso_hosts").([]interface{}) {
+		hosts = append(hosts, host.(string))
+	}
+	resource.EnrollmentSsoConfig = jamfpro.SsoSettingsSubsetEnrollmentSsoConfig{
+		Hosts:          hosts,
+		ManagementHint: d.Get("enrollment_sso_management_hint").(string),
+	}
+
+	if entityID, ok := d.GetOk("entity_id"); ok {
+		resource.EntityId = entityID.(string)
+	}
+
+	// Jamf Pro takes the metadata document base64 encoded.
+	if metadataXML, ok := d.GetOk("idp_metadata_xml"); ok {
+		resource.MetadataSource = metadataSourceFile
+		resource.FederationMetadataFile = base64.StdEncoding.EncodeToString([]byte(metadataXML.(string)))
+		resource.MetadataFileName = d.Get("metadata_file_name").(string)
+		resource.IdpUrl = ""
+	} else if metadataURL, ok := d.GetOk("idp_metadata_url"); ok {
+		resource.MetadataSource = metadataSourceURL
+		resource.IdpUrl = metadataURL.(string)
+		resource.FederationMetadataFile = ""
+	}
+
+	return resource
+}
@@ -0,0 +1,31 @@
+// ssosettings_data_validator.go
+package ssosettings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateMetadataSource requires identity provider metadata, from a URL or inline, when SSO is enabled,
+// and the provider name when the provider type is OTHER.
+func validateMetadataSource(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("idp_provider_type").(string) == "OTHER" && diff.NewValueKnown("other_provider_type_name") && diff.Get("other_provider_type_name").(string) == "" {
+		return fmt.Errorf("'other_provider_type_name' must be set when 'idp_provider_type' is OTHER")
+	}
+
+	if !diff.Get("sso_enabled").(bool) {
+		return nil
+	}
+
+	if !diff.NewValueKnown("idp_metadata_url") || !diff.NewValueKnown("idp_metadata_xml") {
+		return nil
+	}
+
+	if diff.Get("idp_metadata_url").(string) == "" && diff.Get("idp_metadata_xml").(string) == "" {
+		return fmt.Errorf("one of 'idp_metadata_url' or 'idp_metadata_xml' must be set when 'sso_enabled' is true")
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+// ssosettings_resource.go
+package ssosettings
+
+import (
+	"time"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProSsoSettings defines the schema and RU operations for managing the Jamf Pro SAML single sign-on settings in Terraform.
+func ResourceJamfProSsoSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		CustomizeDiff: validateMetadataSource,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: importState,
+		},
+		Schema: map[string]*schema.Schema{
+			"sso_enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether users sign in to Jamf Pro through the identity provider.",
+			},
+			"idp_provider_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ADFS", "OKTA", "GOOGLE", "SHIBBOLETH", "ONELOGIN", "PING", "CENTRIFY", "AZURE", "OTHER"}, false),
+				Description:  "The identity provider: ADFS, OKTA, GOOGLE, SHIBBOLETH, ONELOGIN, PING, CENTRIFY, AZURE or OTHER.",
+			},
+			"other_provider_type_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the identity provider when idp_provider_type is OTHER.",
+			},
+			"idp_metadata_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.IsURLWithHTTPS,
+				ConflictsWith: []string{"idp_metadata_xml"},
+				Description:   "The URL Jamf Pro fetches the identity provider's SAML metadata from. Conflicts with idp_metadata_xml.",
+			},
+			"idp_metadata_xml": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"idp_metadata_url"},
+				DiffSuppressFunc: common.DiffSuppressXML,
+				Description:      "The identity provider's SAML metadata document, inline. Formatting differences are ignored. Conflicts with idp_metadata_url.",
+			},
+			"metadata_file_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "metadata.xml",
+				Description: "The file name Jamf Pro records for inline metadata.",
+			},
+			"entity_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The SAML entity ID of Jamf Pro as a service provider. Jamf Pro's own value is kept when omitted.",
+			},
+			"user_mapping": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "USERNAME",
+				ValidateFunc: validation.StringInSlice([]string{"USERNAME", "EMAIL"}, false),
+				Description:  "Which Jamf Pro user field the SAML subject is matched to: USERNAME or EMAIL.",
+			},
+			"user_attribute_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether users are matched on a SAML attribute instead of the subject.",
+			},
+			"user_attribute_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The SAML attribute users are matched on when user_attribute_enabled is true.",
+			},
+			"group_attribute_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "http://schemas.xmlsoap.org/claims/Group",
+				Description: "The SAML attribute that carries the user's groups.",
+			},
+			"group_rdn_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The RDN key used to extract group names from distinguished names in the group attribute.",
+			},
+			"session_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      480,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "How long an SSO session lasts, in minutes.",
+			},
+			"token_expiration_disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the SAML token expiration from the identity provider is ignored.",
+			},
+			"sso_bypass_allowed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether local Jamf Pro accounts can still sign in without SSO.",
+			},
+			"sso_for_enrollment_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether user-initiated enrollment signs users in through SSO.",
+			},
+			"sso_for_macos_self_service_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether Self Service for macOS signs users in through SSO.",
+			},
+			"enrollment_sso_for_account_driven_enrollment_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether account-driven enrollment signs users in through SSO.",
+			},
+			"group_enrollment_access_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether enrollment through SSO is limited to members of group_enrollment_access_name.",
+			},
+			"group_enrollment_access_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The identity provider group allowed to enroll when group_enrollment_access_enabled is true.",
+			},
+			"enrollment_sso_hosts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The hosts used for SSO during account-driven enrollment.",
+			},
+			"enrollment_sso_management_hint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The management hint sent to devices during account-driven enrollment.",
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+// ssosettings_state.go
+package ssosettings
+
+import (
+	"encoding/base64"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest SSO settings from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceSsoSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ssoData := map[string]interface{}{
+		"sso_enabled":                        resp.SsoEnabled,
+		"idp_provider_type":                  resp.IdpProviderType,
+		"other_provider_type_name":           resp.OtherProviderTypeName,
+		"entity_id":                          resp.EntityId,
+		"user_mapping":                       resp.UserMapping,
+		"user_attribute_enabled":             resp.UserAttributeEnabled,
+		"user_attribute_name":                resp.UserAttributeName,
+		"group_attribute_name":               resp.GroupAttributeName,
+		"group_rdn_key":                      resp.GroupRdnKey,
+		"session_timeout":                    resp.SessionTimeout,
+		"token_expiration_disabled":          resp.TokenExpirationDisabled,
+		"sso_bypass_allowed":                 resp.SsoBypassAllowed,
+		"sso_for_enrollment_enabled":         resp.SsoForEnrollmentEnabled,
+		"sso_for_macos_self_service_enabled": resp.SsoForMacOsSelfServiceEnabled,
+		"enrollment_sso_for_account_driven_enrollment_enabled": resp.EnrollmentSsoForAccountDrivenEnrollmentEnabled,
+		"group_enrollment_access_enabled":                      resp.GroupEnrollmentAccessEnabled,
+		"group_enrollment_access_name":                         resp.GroupEnrollmentAccessName,
+		"enrollment_sso_hosts":                                 resp.EnrollmentSsoConfig.Hosts,
+		"enrollment_sso_management_hint":                       resp.EnrollmentSsoConfig.ManagementHint,
+	}
+
+	switch resp.MetadataSource {
+	case metadataSourceURL:
+		ssoData["idp_metadata_url"] = resp.IdpUrl
+		ssoData["idp_metadata_xml"] = ""
+	case metadataSourceFile:
+		ssoData["idp_metadata_url"] = ""
+		ssoData["idp_metadata_xml"] = decodeMetadata(resp.FederationMetadataFile)
+		ssoData["metadata_file_name"] = resp.MetadataFileName
+	}
+
+	for key, val := range ssoData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return diags
+}
+
+// decodeMetadata returns the metadata document Jamf Pro stores base64 encoded, or the value unchanged
+// if it is not valid base64.
+func decodeMetadata(encoded string) string {
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return encoded
+	}
+	return string(decoded)
+}
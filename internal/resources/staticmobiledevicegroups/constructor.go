@@ -0,0 +1,36 @@
+package staticmobiledevicegroups
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a static ResourceMobileDeviceGroup object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceMobileDeviceGroup, error) {
+	resource := &jamfpro.ResourceMobileDeviceGroup{
+		Name:    d.Get("name").(string),
+		IsSmart: false,
+		Site:    *sharedschemas.ConstructSharedResourceSite(d.Get("site_id").(int)),
+	}
+
+	for _, v := range d.Get("assigned_mobile_device_ids").(*schema.Set).List() {
+		resource.MobileDevices = append(resource.MobileDevices, jamfpro.MobileDeviceGroupSubsetDeviceItem{
+			ID: v.(int),
+		})
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro Static Mobile Device Group '%s' to XML: %v", resource.Name, err)
+	}
+
+	common.LogConstructedPayload(ctx, "Jamf Pro Static Mobile Device Group XML", string(resourceXML))
+
+	return resource, nil
+}
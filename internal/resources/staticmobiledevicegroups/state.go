@@ -0,0 +1,44 @@
+package staticmobiledevicegroups
+
+import (
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest Static Mobile Device Group information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceMobileDeviceGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Updates always send is_smart false, which would discard the criteria of a group converted to smart in Jamf Pro.
+	if resp.IsSmart {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Mobile device group is no longer a static group",
+			Detail:   fmt.Sprintf("Jamf Pro mobile device group '%s' (ID: %s) is now a smart group. Manage it with jamfpro_smart_mobile_device_group, or remove it from state and recreate it as a static group.", resp.Name, d.Id()),
+		}}
+	}
+
+	if err := d.Set("name", resp.Name); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("is_smart", resp.IsSmart); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("site_id", resp.Site.ID); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	deviceIDs := make([]interface{}, 0, len(resp.MobileDevices))
+	for _, device := range resp.MobileDevices {
+		deviceIDs = append(deviceIDs, device.ID)
+	}
+
+	if err := d.Set("assigned_mobile_device_ids", schema.NewSet(schema.HashInt, deviceIDs)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
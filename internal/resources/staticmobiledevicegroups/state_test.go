@@ -0,0 +1,124 @@
+package staticmobiledevicegroups
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/jamfmock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const groupPath = "/JSSResource/mobiledevicegroups/id/9"
+
+func TestReadRejectsSmartGroup(t *testing.T) {
+	cases := []struct {
+		name    string
+		isSmart bool
+		wantErr bool
+	}{
+		{name: "static group", isSmart: false},
+		{name: "converted to smart in Jamf Pro", isSmart: true, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			isSmart := "false"
+			if tc.isSmart {
+				isSmart = "true"
+			}
+
+			server := jamfmock.NewServer()
+			defer server.Close()
+			server.Handle(http.MethodGet, groupPath, jamfmock.XML(http.StatusOK,
+				"<mobile_device_group><id>9</id><name>Loaner iPads</name><is_smart>"+isSmart+"</is_smart>"+
+					"<site><id>-1</id><name>None</name></site></mobile_device_group>"))
+
+			client, err := server.Client()
+			if err != nil {
+				t.Fatalf("building mock client: %v", err)
+			}
+
+			d := schema.TestResourceDataRaw(t, ResourceJamfProStaticMobileDeviceGroups().Schema, map[string]interface{}{"name": "Loaner iPads"})
+			d.SetId("9")
+
+			diags := readWithCleanup(context.Background(), d, client)
+
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", diags, tc.wantErr)
+			}
+			if tc.wantErr && !strings.Contains(diags[0].Summary, "no longer a static group") {
+				t.Fatalf("got summary %q, want the static group mismatch", diags[0].Summary)
+			}
+			// The group stays in state so that it is not recreated over the smart group.
+			if d.Id() != "9" {
+				t.Fatalf("got ID %q, want the group kept in state", d.Id())
+			}
+			if !tc.wantErr && d.Get("is_smart").(bool) {
+				t.Fatal("got is_smart true in state for a static group")
+			}
+		})
+	}
+}
+
+// TestReadMembershipOrder reads a group whose members Jamf Pro returns in a different order than configured
+// and checks that only a change in membership produces a plan.
+func TestReadMembershipOrder(t *testing.T) {
+	cases := []struct {
+		name       string
+		configured []interface{}
+		wantDiff   bool
+	}{
+		{name: "members returned in reverse", configured: []interface{}{1, 2, 3}},
+		{name: "member added in configuration", configured: []interface{}{1, 2, 3, 4}, wantDiff: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := jamfmock.NewServer()
+			defer server.Close()
+			server.Handle(http.MethodGet, groupPath, jamfmock.XML(http.StatusOK,
+				"<mobile_device_group><id>9</id><name>Loaner iPads</name><is_smart>false</is_smart>"+
+					"<site><id>-1</id><name>None</name></site><mobile_devices>"+
+					"<mobile_device><id>3</id></mobile_device><mobile_device><id>2</id></mobile_device><mobile_device><id>1</id></mobile_device>"+
+					"</mobile_devices></mobile_device_group>"))
+
+			client, err := server.Client()
+			if err != nil {
+				t.Fatalf("building mock client: %v", err)
+			}
+
+			resource := ResourceJamfProStaticMobileDeviceGroups()
+			config := map[string]interface{}{
+				"name":                       "Loaner iPads",
+				"assigned_mobile_device_ids": tc.configured,
+			}
+
+			d := schema.TestResourceDataRaw(t, resource.Schema, config)
+			d.SetId("9")
+
+			if diags := readWithCleanup(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("read: %v", diags)
+			}
+
+			diff, err := resource.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+			if err != nil {
+				t.Fatalf("planning: %v", err)
+			}
+
+			planned := false
+			if diff != nil {
+				for key := range diff.Attributes {
+					if strings.HasPrefix(key, "assigned_mobile_device_ids") {
+						planned = true
+					}
+				}
+			}
+			if planned != tc.wantDiff {
+				t.Fatalf("got assigned_mobile_device_ids diff %t, want %t: %v", planned, tc.wantDiff, diff)
+			}
+		})
+	}
+}
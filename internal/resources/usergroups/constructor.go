@@ -2,17 +2,24 @@
 package usergroups
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
-	"log"
+	"strconv"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
 	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common/sharedschemas"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // constructJamfProUserGroup constructs a ResourceUserGroup object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceUserGroup, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceUserGroup, error) {
+	var err error
+	if err = sharedschemas.ValidateCriteriaRegex(d.Get("criteria").([]interface{})); err != nil {
+		return nil, err
+	}
+
 	resource := &jamfpro.ResourceUserGroup{
 		Name:             d.Get("name").(string),
 		IsSmart:          d.Get("is_smart").(bool),
@@ -46,15 +53,19 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceUserGroup, error) {
 		}
 	}
 
-	resource.UserAdditions = extractUsers(d.Get("user_additions").([]interface{}))
-	resource.UserDeletions = extractUsers(d.Get("user_deletions").([]interface{}))
+	if resource.UserAdditions, err = extractUsers(d.Get("user_additions").([]interface{})); err != nil {
+		return nil, fmt.Errorf("invalid user_additions in Jamf Pro User Group '%s': %v", resource.Name, err)
+	}
+	if resource.UserDeletions, err = extractUsers(d.Get("user_deletions").([]interface{})); err != nil {
+		return nil, fmt.Errorf("invalid user_deletions in Jamf Pro User Group '%s': %v", resource.Name, err)
+	}
 
 	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal Jamf Pro User Group  '%s' to XML: %v", resource.Name, err)
 	}
 
-	log.Printf("[DEBUG] Constructed Jamf Pro User Group  XML:\n%s\n", string(resourceXML))
+	common.LogConstructedPayload(ctx, "Jamf Pro User Group XML", string(resourceXML))
 
 	return resource, nil
 }
@@ -63,13 +74,22 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceUserGroup, error) {
 // of jamfpro.UserGroupSubsetUserItem. It iterates over each user in the interface
 // slice, extracts the relevant fields, and constructs a UserGroupSubsetUserItem for
 // each user. The resulting slice of UserGroupSubsetUserItem is suitable for use in
-// constructing a jamfpro.ResourceUserGroup object.
-func extractUsers(usersInterface []interface{}) []jamfpro.UserGroupSubsetUserItem {
+// constructing a jamfpro.ResourceUserGroup object. An error is returned if a user ID is not numeric.
+func extractUsers(usersInterface []interface{}) ([]jamfpro.UserGroupSubsetUserItem, error) {
 	var users []jamfpro.UserGroupSubsetUserItem
 	for _, user := range usersInterface {
 		u := user.(map[string]interface{})
+
+		id := 0
+		if idString := u["id"].(string); idString != "" {
+			var err error
+			if id, err = strconv.Atoi(idString); err != nil {
+				return nil, fmt.Errorf("user id '%s' is not a number", idString)
+			}
+		}
+
 		userItem := jamfpro.UserGroupSubsetUserItem{
-			ID:           u["id"].(int),
+			ID:           id,
 			Username:     u["username"].(string),
 			FullName:     u["full_name"].(string),
 			PhoneNumber:  u["phone_number"].(string),
@@ -77,5 +97,5 @@ func extractUsers(usersInterface []interface{}) []jamfpro.UserGroupSubsetUserIte
 		}
 		users = append(users, userItem)
 	}
-	return users
+	return users, nil
 }
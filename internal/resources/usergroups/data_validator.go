@@ -21,7 +21,8 @@ func mainCustomDiffFunc(ctx context.Context, diff *schema.ResourceDiff, i interf
 	return nil
 }
 
-// validateIsSmartAttribute checks the conditions related to the 'is_smart' attribute.
+// validateIsSmartAttribute checks that smart groups are defined only by criteria and static groups only by
+// their members: assigned_user_ids, user_additions and user_deletions.
 func validateIsSmartAttribute(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
 	resourceName := diff.Get("name").(string)
 	isSmart, ok := diff.GetOkExists("is_smart")
@@ -33,8 +34,12 @@ func validateIsSmartAttribute(_ context.Context, diff *schema.ResourceDiff, _ in
 	usersBlockExists := len(diff.Get("assigned_user_ids").([]interface{})) > 0
 	criteriaBlockExists := len(diff.Get("criteria").([]interface{})) > 0
 
-	if isSmart.(bool) && usersBlockExists {
-		return fmt.Errorf("in 'jamfpro_user_group.%s': 'users' block is not allowed when 'is_smart' is set to true", resourceName)
+	if isSmart.(bool) {
+		for _, field := range []string{"assigned_user_ids", "user_additions", "user_deletions"} {
+			if len(diff.Get(field).([]interface{})) > 0 {
+				return fmt.Errorf("in 'jamfpro_user_group.%s': '%s' is not allowed when 'is_smart' is set to true; smart group membership is determined by 'criteria'", resourceName, field)
+			}
+		}
 	}
 
 	if !isSmart.(bool) && criteriaBlockExists {
@@ -46,7 +51,7 @@ func validateIsSmartAttribute(_ context.Context, diff *schema.ResourceDiff, _ in
 	}
 
 	if !isSmart.(bool) && !usersBlockExists {
-		return fmt.Errorf("in 'jamfpro_user_group.%s': 'users' block is required when 'is_smart' is set to false", resourceName)
+		return fmt.Errorf("in 'jamfpro_user_group.%s': 'assigned_user_ids' is required when 'is_smart' is set to false", resourceName)
 	}
 
 	return nil
@@ -0,0 +1,28 @@
+package usergroups
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProUserGroupsList provides the ID and name of every user group in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProUserGroupsList() *schema.Resource {
+	return common.ListDataSource("user group", listNames)
+}
+
+// listNames returns the ID and name of every user group in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetUserGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.UserGroup))
+	for _, item := range response.UserGroup {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}
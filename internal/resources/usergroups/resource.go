@@ -56,7 +56,7 @@ func ResourceJamfProUserGroups() *schema.Resource {
 			"is_smart": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Indicates if the user group is a smart group.",
+				Description: "Indicates if the user group is a smart group. Smart groups require criteria; static groups require assigned_user_ids.",
 			},
 			"is_notify_on_change": {
 				Type:        schema.TypeBool,
@@ -125,7 +125,7 @@ func ResourceJamfProUserGroups() *schema.Resource {
 			"assigned_user_ids": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Description: "assigned computer by ids",
+				Description: "The IDs of the users assigned to a static user group. Not allowed when is_smart is true.",
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
@@ -0,0 +1,41 @@
+package users
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// construct builds a ResourceUser object from the provided schema data.
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceUser, error) {
+	resource := &jamfpro.ResourceUser{
+		Name:         d.Get("name").(string),
+		FullName:     d.Get("full_name").(string),
+		Email:        d.Get("email").(string),
+		EmailAddress: d.Get("email").(string),
+		PhoneNumber:  d.Get("phone_number").(string),
+		Position:     d.Get("position").(string),
+		LDAPServer: jamfpro.UserSubsetLDAPServer{
+			ID: d.Get("ldap_server_id").(int),
+		},
+	}
+
+	for _, v := range d.Get("site_ids").(*schema.Set).List() {
+		resource.Sites = append(resource.Sites, jamfpro.SharedResourceSite{
+			ID: v.(int),
+		})
+	}
+
+	resourceXML, err := xml.MarshalIndent(resource, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Jamf Pro User '%s' to XML: %v", resource.Name, err)
+	}
+
+	common.LogConstructedPayload(ctx, "Jamf Pro User XML", string(resourceXML))
+
+	return resource, nil
+}
@@ -0,0 +1,82 @@
+package users
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceJamfProUsers defines the schema and CRUD operations for managing Jamf Pro users in Terraform.
+func ResourceJamfProUsers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: create,
+		ReadContext:   readWithCleanup,
+		UpdateContext: update,
+		DeleteContext: delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(70 * time.Second),
+			Read:   schema.DefaultTimeout(15 * time.Second),
+			Update: schema.DefaultTimeout(30 * time.Second),
+			Delete: schema.DefaultTimeout(15 * time.Second),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the Jamf Pro user.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The unique username of the Jamf Pro user.",
+			},
+			"full_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The full name of the user.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The email address of the user.",
+			},
+			"phone_number": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The phone number of the user.",
+			},
+			"position": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The position or job title of the user.",
+			},
+			"ldap_server_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The ID of the LDAP server the user is linked to. 0 means the user is not linked to a directory.",
+			},
+			"site_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The IDs of the sites the user belongs to.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"user_group_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The IDs of the static user groups the user is a member of. Membership is managed with assigned_user_ids on jamfpro_user_group, as the Jamf Pro user record does not accept groups.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
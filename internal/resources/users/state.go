@@ -0,0 +1,81 @@
+package users
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// updateState updates the Terraform state with the latest User information from the Jamf Pro API.
+func updateState(d *schema.ResourceData, resp *jamfpro.ResourceUser) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Jamf Pro returns the address in either element depending on the version, so prefer email_address.
+	email := resp.EmailAddress
+	if email == "" {
+		email = resp.Email
+	}
+
+	userData := map[string]interface{}{
+		"name":           common.DecodeName(resp.Name),
+		"full_name":      resp.FullName,
+		"email":          email,
+		"phone_number":   resp.PhoneNumber,
+		"position":       resp.Position,
+		"ldap_server_id": resp.LDAPServer.ID,
+	}
+
+	for key, val := range userData {
+		if err := d.Set(key, val); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	siteIDs := make([]interface{}, 0, len(resp.Sites))
+	for _, site := range resp.Sites {
+		siteIDs = append(siteIDs, site.ID)
+	}
+
+	if err := d.Set("site_ids", schema.NewSet(schema.HashInt, siteIDs)); err != nil {
+		diags = append(diags, diag.FromErr(err)...)
+	}
+
+	return diags
+}
+
+// updateGroupMembershipState states the IDs of the static user groups that include userID. Jamf Pro does
+// not return group membership on the user record, so every static user group is fetched to find it.
+func updateGroupMembershipState(d *schema.ResourceData, client *jamfpro.Client, userID string) diag.Diagnostics {
+	groups, err := client.GetUserGroups()
+	if err != nil {
+		return diag.Errorf("failed to list Jamf Pro User Groups to find the groups of user (ID: %s): %v", userID, err)
+	}
+
+	groupIDs := make([]interface{}, 0)
+	for _, listItem := range groups.UserGroup {
+		if listItem.IsSmart {
+			continue
+		}
+
+		group, err := client.GetUserGroupByID(strconv.Itoa(listItem.ID))
+		if err != nil {
+			return diag.Errorf("failed to read Jamf Pro User Group '%s' (ID: %d) to find the groups of user (ID: %s): %v", listItem.Name, listItem.ID, userID, err)
+		}
+
+		for _, member := range group.Users {
+			if strconv.Itoa(member.ID) == userID {
+				groupIDs = append(groupIDs, listItem.ID)
+				break
+			}
+		}
+	}
+
+	if err := d.Set("user_group_ids", schema.NewSet(schema.HashInt, groupIDs)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
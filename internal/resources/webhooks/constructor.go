@@ -2,6 +2,7 @@
 package webhooks
 
 import (
+	"context"
 	"log"
 
 	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
@@ -10,7 +11,7 @@ import (
 )
 
 // constructJamfProWebhook constructs a ResourceWebhook object from the provided schema data.
-func construct(d *schema.ResourceData) (*jamfpro.ResourceWebhook, error) {
+func construct(ctx context.Context, d *schema.ResourceData) (*jamfpro.ResourceWebhook, error) {
 	resource := &jamfpro.ResourceWebhook{
 		Name:                        d.Get("name").(string),
 		Enabled:                     d.Get("enabled").(bool),
@@ -38,7 +39,7 @@ func construct(d *schema.ResourceData) (*jamfpro.ResourceWebhook, error) {
 	if err != nil {
 		log.Fatalf("Error serializing webhook to XML: %v", err)
 	}
-	log.Printf("[DEBUG] Constructed Jamf Pro Webhook XML:\n%s\n", xmlOutput)
+	common.LogConstructedPayload(ctx, "Jamf Pro Webhook XML", xmlOutput)
 
 	return resource, nil
 }
@@ -0,0 +1,28 @@
+package webhooks
+
+import (
+	"strconv"
+
+	"github.com/deploymenttheory/go-api-sdk-jamfpro/sdk/jamfpro"
+	"github.com/deploymenttheory/terraform-provider-jamfpro/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceJamfProWebhooksList provides the ID and name of every webhook in Jamf Pro, for use when bulk importing.
+func DataSourceJamfProWebhooksList() *schema.Resource {
+	return common.ListDataSource("webhook", listNames)
+}
+
+// listNames returns the ID and name of every webhook in Jamf Pro.
+func listNames(client *jamfpro.Client) ([]common.NamedObject, error) {
+	response, err := client.GetWebhooks()
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]common.NamedObject, 0, len(response.Webhooks))
+	for _, item := range response.Webhooks {
+		objects = append(objects, common.NamedObject{ID: strconv.Itoa(item.ID), Name: item.Name})
+	}
+	return objects, nil
+}